@@ -0,0 +1,103 @@
+package lists
+
+// Chunk splits list into consecutive chunks of n elements. The last chunk holds the remaining elements and may be shorter. The chunks are views into list, as in ChunkEvery. Returns false if n is not positive.
+func Chunk[T any](n int, list []T) ([][]T, bool) {
+	return ChunkEvery(n, n, list)
+}
+
+// ChunkBy splits list into chunks of consecutive elements for which key(elem) returns the same value. A new chunk starts whenever the key changes. The chunks are views into list, as in ChunkEvery.
+func ChunkBy[T any, K comparable](key func(T) K, list []T) [][]T {
+	newList := make([][]T, 0)
+	start := 0
+	var last K
+	for i, v := range list {
+		k := key(v)
+		if i > 0 && k != last {
+			newList = append(newList, list[start:i:i])
+			start = i
+		}
+		last = k
+	}
+	if start < len(list) {
+		newList = append(newList, list[start:len(list):len(list)])
+	}
+	return newList
+}
+
+// ChunkEvery splits list into chunks of n elements, starting a new chunk every step elements. With step smaller than n the chunks overlap, with step greater than n elements are skipped between chunks. The last chunk holds the remaining elements and may be shorter. The chunks are views into the backing array of list rather than copies, so that splitting a large list is cheap; each view is capped at its length, so appending to one never overwrites its neighbours, but changes to the elements of list are visible through the views. Use CloneAll to get independent copies. Returns false if n or step is not positive.
+func ChunkEvery[T any](n, step int, list []T) ([][]T, bool) {
+	if n <= 0 || step <= 0 {
+		return nil, false
+	}
+	newList := make([][]T, 0)
+	for start := 0; start < len(list); start += step {
+		end := start + n
+		if end >= len(list) {
+			newList = append(newList, list[start:len(list):len(list)])
+			break
+		}
+		newList = append(newList, list[start:end:end])
+	}
+	return newList, true
+}
+
+// ChunkExact is like Chunk, but a last chunk shorter than n elements is dropped. The chunks are views into list, as in ChunkEvery.
+func ChunkExact[T any](n int, list []T) ([][]T, bool) {
+	if n <= 0 {
+		return nil, false
+	}
+	return Chunk(n, list[:len(list)-len(list)%n])
+}
+
+// ChunkPad is like Chunk, but a last chunk shorter than n elements is padded with fill. The padded chunk is a copy, the others are views into list, as in ChunkEvery.
+func ChunkPad[T any](n int, fill T, list []T) ([][]T, bool) {
+	newList, ok := Chunk(n, list)
+	if ok && len(newList) > 0 {
+		last := newList[len(newList)-1]
+		newList[len(newList)-1] = Concat(last, Duplicate(fill, n-len(last)))
+	}
+	return newList, ok
+}
+
+// ChunkWhile splits list into chunks of consecutive elements. fun(prev, next) is called on every pair of adjacent elements, and a new chunk starts at next whenever it returns false. The chunks are views into list, as in ChunkEvery.
+func ChunkWhile[T any](fun func(prev, next T) bool, list []T) [][]T {
+	newList := make([][]T, 0)
+	start := 0
+	for i := 1; i <= len(list); i++ {
+		if i == len(list) || !fun(list[i-1], list[i]) {
+			newList = append(newList, list[start:i:i])
+			start = i
+		}
+	}
+	return newList
+}
+
+// CloneAll returns a copy of lists where every sublist is a copy as well, so that none of them shares memory with the original lists.
+func CloneAll[T any](lists [][]T) [][]T {
+	flat := Flatten(lists)
+	newList := make([][]T, len(lists))
+	for i, list := range lists {
+		newList[i] = flat[:len(list):len(list)]
+		flat = flat[len(list):]
+	}
+	return newList
+}
+
+// Pairwise returns the list of all pairs of adjacent elements of list.
+func Pairwise[T any](list []T) []Pair[T, T] {
+	if len(list) < 2 {
+		return []Pair[T, T]{}
+	}
+	return ZipShortest(list, list[1:])
+}
+
+// Windows returns all sliding windows of n consecutive elements of list, starting at every position. The windows are overlapping views into list, as in ChunkEvery. Returns false if n is not positive.
+func Windows[T any](n int, list []T) ([][]T, bool) {
+	if n <= 0 {
+		return nil, false
+	}
+	if n > len(list) {
+		return [][]T{}, true
+	}
+	return ChunkEvery(n, 1, list)
+}
@@ -0,0 +1,108 @@
+package lists
+
+import (
+	"testing"
+)
+
+// equalAll reports whether lists1 and lists2 hold the same sublists in the same order.
+func equalAll[T comparable](lists1, lists2 [][]T) bool {
+	if len(lists1) != len(lists2) {
+		return false
+	}
+	for i := range lists1 {
+		if !equal(lists1[i], lists2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestChunk(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	c, ok := Chunk(2, list)
+	if !ok || !equalAll(c, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Error("Chunk(2, []int{1, 2, 3, 4, 5}) != [][]int{{1, 2}, {3, 4}, {5}}")
+	}
+	_ = append(c[0], 99)
+	if list[2] != 3 {
+		t.Error("append(Chunk(2, list)[0], 99) overwrote list")
+	}
+	if _, ok = Chunk(0, list); ok {
+		t.Error("Chunk(0, list) != false")
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	c := ChunkBy(func(x int) bool { return x%2 == 0 }, []int{1, 3, 2, 4, 5})
+	if !equalAll(c, [][]int{{1, 3}, {2, 4}, {5}}) {
+		t.Error("ChunkBy(isEven, []int{1, 3, 2, 4, 5}) != [][]int{{1, 3}, {2, 4}, {5}}")
+	}
+	if len(ChunkBy(func(x int) int { return x }, []int{})) != 0 {
+		t.Error("ChunkBy(identity, []int{}) != [][]int{}")
+	}
+}
+
+func TestChunkEvery(t *testing.T) {
+	c, ok := ChunkEvery(3, 2, []int{1, 2, 3, 4, 5, 6})
+	if !ok || !equalAll(c, [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6}}) {
+		t.Error("ChunkEvery(3, 2, []int{1, 2, 3, 4, 5, 6}) != [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6}}")
+	}
+	c, ok = ChunkEvery(1, 3, []int{1, 2, 3, 4, 5})
+	if !ok || !equalAll(c, [][]int{{1}, {4}}) {
+		t.Error("ChunkEvery(1, 3, []int{1, 2, 3, 4, 5}) != [][]int{{1}, {4}}")
+	}
+	if _, ok = ChunkEvery(2, 0, []int{1}); ok {
+		t.Error("ChunkEvery(2, 0, []int{1}) != false")
+	}
+}
+
+func TestChunkExact(t *testing.T) {
+	c, ok := ChunkExact(2, []int{1, 2, 3, 4, 5})
+	if !ok || !equalAll(c, [][]int{{1, 2}, {3, 4}}) {
+		t.Error("ChunkExact(2, []int{1, 2, 3, 4, 5}) != [][]int{{1, 2}, {3, 4}}")
+	}
+}
+
+func TestChunkPad(t *testing.T) {
+	c, ok := ChunkPad(2, 0, []int{1, 2, 3})
+	if !ok || !equalAll(c, [][]int{{1, 2}, {3, 0}}) {
+		t.Error("ChunkPad(2, 0, []int{1, 2, 3}) != [][]int{{1, 2}, {3, 0}}")
+	}
+}
+
+func TestChunkWhile(t *testing.T) {
+	c := ChunkWhile(func(prev, next int) bool { return next == prev+1 }, []int{1, 2, 4, 9, 10, 11})
+	if !equalAll(c, [][]int{{1, 2}, {4}, {9, 10, 11}}) {
+		t.Error("ChunkWhile(consecutive, []int{1, 2, 4, 9, 10, 11}) != [][]int{{1, 2}, {4}, {9, 10, 11}}")
+	}
+}
+
+func TestCloneAll(t *testing.T) {
+	list := []int{1, 2, 3, 4}
+	c, _ := Chunk(2, list)
+	clone := CloneAll(c)
+	list[0] = 99
+	if !equalAll(clone, [][]int{{1, 2}, {3, 4}}) {
+		t.Error("CloneAll(Chunk(2, list)) shares memory with list")
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	if !equal(Pairwise([]int{1, 2, 3}), []Pair[int, int]{{1, 2}, {2, 3}}) {
+		t.Error("Pairwise([]int{1, 2, 3}) != []Pair[int, int]{{1, 2}, {2, 3}}")
+	}
+	if len(Pairwise([]int{1})) != 0 {
+		t.Error("Pairwise([]int{1}) != []Pair[int, int]{}")
+	}
+}
+
+func TestWindows(t *testing.T) {
+	w, ok := Windows(3, []int{1, 2, 3, 4})
+	if !ok || !equalAll(w, [][]int{{1, 2, 3}, {2, 3, 4}}) {
+		t.Error("Windows(3, []int{1, 2, 3, 4}) != [][]int{{1, 2, 3}, {2, 3, 4}}")
+	}
+	w, ok = Windows(5, []int{1, 2, 3, 4})
+	if !ok || len(w) != 0 {
+		t.Error("Windows(5, []int{1, 2, 3, 4}) != [][]int{}")
+	}
+}
@@ -0,0 +1,104 @@
+package lists
+
+// InsertAt returns a copy of list with t inserted at position i, counting from 0 as in Nth, so that t is the element at position i of the new list. i may be equal to the length of list, in which case t is appended. Returns false if i is out of range.
+func InsertAt[T any](i int, t T, list []T) ([]T, bool) {
+	if i < 0 || i > len(list) {
+		return nil, false
+	}
+	newList := make([]T, 0, len(list)+1)
+	newList = append(newList, list[:i]...)
+	newList = append(newList, t)
+	return append(newList, list[i:]...), true
+}
+
+// Move returns a copy of list where the element at position from has been moved to position to, shifting the elements between them by one position. Returns false if from or to is out of range.
+func Move[T any](from, to int, list []T) ([]T, bool) {
+	if from < 0 || from >= len(list) || to < 0 || to >= len(list) {
+		return nil, false
+	}
+	newList := clone(list)
+	v := newList[from]
+	if from < to {
+		copy(newList[from:to], newList[from+1:to+1])
+	} else {
+		copy(newList[to+1:from+1], newList[to:from])
+	}
+	newList[to] = v
+	return newList, true
+}
+
+// RemoveAt returns a copy of list with the element at position i, counting from 0, removed. Returns false if i is out of range.
+func RemoveAt[T any](i int, list []T) ([]T, bool) {
+	if i < 0 || i >= len(list) {
+		return nil, false
+	}
+	newList := make([]T, 0, len(list)-1)
+	newList = append(newList, list[:i]...)
+	return append(newList, list[i+1:]...), true
+}
+
+// ReplaceAt returns a copy of list with the element at position i replaced by t. Returns false if i is out of range.
+func ReplaceAt[T any](i int, t T, list []T) ([]T, bool) {
+	return UpdateAt(i, func(T) T { return t }, list)
+}
+
+// RotateLeft returns a copy of list rotated n positions to the left, so that the element at position n comes first. n may be negative or greater than the length of list.
+func RotateLeft[T any](n int, list []T) []T {
+	if len(list) == 0 {
+		return []T{}
+	}
+	n %= len(list)
+	if n < 0 {
+		n += len(list)
+	}
+	newList := make([]T, 0, len(list))
+	newList = append(newList, list[n:]...)
+	return append(newList, list[:n]...)
+}
+
+// RotateRight returns a copy of list rotated n positions to the right, so that the last n elements come first. n may be negative or greater than the length of list.
+func RotateRight[T any](n int, list []T) []T {
+	return RotateLeft(-n, list)
+}
+
+// Splice returns a copy of list where k elements starting at position start have been removed and the elements of items inserted in their place, together with a copy of the removed elements. It is not an error for start+k to exceed the length of the list. Returns false if start is out of range or k is negative.
+func Splice[T any](start, k int, items []T, list []T) ([]T, []T, bool) {
+	if start < 0 || start > len(list) || k < 0 {
+		return nil, nil, false
+	}
+	if k > len(list)-start {
+		k = len(list) - start
+	}
+	newList := make([]T, 0, len(list)-k+len(items))
+	newList = append(newList, list[:start]...)
+	newList = append(newList, items...)
+	newList = append(newList, list[start+k:]...)
+	return newList, clone(list[start : start+k]), true
+}
+
+// Swap returns a copy of list where the elements at positions i and j have been exchanged. Returns false if i or j is out of range.
+func Swap[T any](i, j int, list []T) ([]T, bool) {
+	if i < 0 || i >= len(list) || j < 0 || j >= len(list) {
+		return nil, false
+	}
+	newList := clone(list)
+	newList[i], newList[j] = newList[j], newList[i]
+	return newList, true
+}
+
+// UpdateAt returns a copy of list with the element at position i replaced by fun(elem). Returns false if i is out of range.
+func UpdateAt[T any](i int, fun func(T) T, list []T) ([]T, bool) {
+	if i < 0 || i >= len(list) {
+		return nil, false
+	}
+	newList := clone(list)
+	newList[i] = fun(newList[i])
+	return newList, true
+}
+
+// clone returns a copy of list that does not share memory with it.
+func clone[T any](list []T) []T {
+	newList := make([]T, len(list))
+	copy(newList, list)
+	return newList
+}
@@ -0,0 +1,120 @@
+package lists
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInsertAt(t *testing.T) {
+	list := make([]int, 3, 10)
+	copy(list, []int{1, 2, 3})
+	result, ok := InsertAt(1, 9, list)
+	if !ok || !equal(result, []int{1, 9, 2, 3}) {
+		t.Error("InsertAt(1, 9, []int{1, 2, 3}) != []int{1, 9, 2, 3}")
+	}
+	if list[1] != 2 || list[:4][3] != 0 {
+		t.Error("InsertAt(1, 9, list) modified list")
+	}
+	result, ok = InsertAt(3, 9, list)
+	if !ok || !equal(result, []int{1, 2, 3, 9}) {
+		t.Error("InsertAt(3, 9, []int{1, 2, 3}) != []int{1, 2, 3, 9}")
+	}
+	if _, ok = InsertAt(4, 9, list); ok {
+		t.Error("InsertAt(4, 9, []int{1, 2, 3}) != false")
+	}
+}
+
+func TestMove(t *testing.T) {
+	result, ok := Move(0, 2, []int{1, 2, 3, 4})
+	if !ok || !equal(result, []int{2, 3, 1, 4}) {
+		t.Error("Move(0, 2, []int{1, 2, 3, 4}) != []int{2, 3, 1, 4}")
+	}
+	result, ok = Move(3, 1, []int{1, 2, 3, 4})
+	if !ok || !equal(result, []int{1, 4, 2, 3}) {
+		t.Error("Move(3, 1, []int{1, 2, 3, 4}) != []int{1, 4, 2, 3}")
+	}
+	if _, ok = Move(0, 4, []int{1, 2, 3, 4}); ok {
+		t.Error("Move(0, 4, []int{1, 2, 3, 4}) != false")
+	}
+}
+
+func TestRemoveAt(t *testing.T) {
+	list := []int{1, 2, 3}
+	result, ok := RemoveAt(0, list)
+	if !ok || !equal(result, []int{2, 3}) || list[0] != 1 {
+		t.Error("RemoveAt(0, []int{1, 2, 3}) != []int{2, 3}")
+	}
+	if _, ok = RemoveAt(-1, list); ok {
+		t.Error("RemoveAt(-1, []int{1, 2, 3}) != false")
+	}
+}
+
+func TestReplaceAt(t *testing.T) {
+	list := []int{1, 2, 3}
+	result, ok := ReplaceAt(2, 9, list)
+	if !ok || !equal(result, []int{1, 2, 9}) || list[2] != 3 {
+		t.Error("ReplaceAt(2, 9, []int{1, 2, 3}) != []int{1, 2, 9}")
+	}
+	if _, ok = ReplaceAt(3, 9, list); ok {
+		t.Error("ReplaceAt(3, 9, []int{1, 2, 3}) != false")
+	}
+}
+
+func TestRotateLeft(t *testing.T) {
+	if !equal(RotateLeft(1, []int{1, 2, 3}), []int{2, 3, 1}) {
+		t.Error("RotateLeft(1, []int{1, 2, 3}) != []int{2, 3, 1}")
+	}
+	if !equal(RotateLeft(-4, []int{1, 2, 3}), []int{3, 1, 2}) {
+		t.Error("RotateLeft(-4, []int{1, 2, 3}) != []int{3, 1, 2}")
+	}
+	if len(RotateLeft(2, []int{})) != 0 {
+		t.Error("RotateLeft(2, []int{}) != []int{}")
+	}
+}
+
+func TestRotateRight(t *testing.T) {
+	if !equal(RotateRight(4, []int{1, 2, 3}), []int{3, 1, 2}) {
+		t.Error("RotateRight(4, []int{1, 2, 3}) != []int{3, 1, 2}")
+	}
+}
+
+func TestSplice(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	result, removed, ok := Splice(1, 2, []int{8, 9, 10}, list)
+	if !ok || !equal(result, []int{1, 8, 9, 10, 4, 5}) || !equal(removed, []int{2, 3}) {
+		t.Error("Splice(1, 2, []int{8, 9, 10}, []int{1, 2, 3, 4, 5}) != []int{1, 8, 9, 10, 4, 5}, []int{2, 3}")
+	}
+	removed[0] = 0
+	if list[1] != 2 {
+		t.Error("Splice(1, 2, items, list) returned removed elements sharing memory with list")
+	}
+	result, removed, ok = Splice(3, 10, nil, list)
+	if !ok || !equal(result, []int{1, 2, 3}) || !equal(removed, []int{4, 5}) {
+		t.Error("Splice(3, 10, nil, []int{1, 2, 3, 4, 5}) != []int{1, 2, 3}, []int{4, 5}")
+	}
+	result, removed, ok = Splice(1, math.MaxInt, nil, list)
+	if !ok || !equal(result, []int{1}) || !equal(removed, []int{2, 3, 4, 5}) {
+		t.Error("Splice(1, math.MaxInt, nil, []int{1, 2, 3, 4, 5}) != []int{1}, []int{2, 3, 4, 5}")
+	}
+	if _, _, ok = Splice(6, 0, nil, list); ok {
+		t.Error("Splice(6, 0, nil, []int{1, 2, 3, 4, 5}) != false")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	list := []int{1, 2, 3}
+	result, ok := Swap(0, 2, list)
+	if !ok || !equal(result, []int{3, 2, 1}) || list[0] != 1 {
+		t.Error("Swap(0, 2, []int{1, 2, 3}) != []int{3, 2, 1}")
+	}
+	if _, ok = Swap(0, 3, list); ok {
+		t.Error("Swap(0, 3, []int{1, 2, 3}) != false")
+	}
+}
+
+func TestUpdateAt(t *testing.T) {
+	result, ok := UpdateAt(1, func(x int) int { return x * 10 }, []int{1, 2, 3})
+	if !ok || !equal(result, []int{1, 20, 3}) {
+		t.Error("UpdateAt(1, times10, []int{1, 2, 3}) != []int{1, 20, 3}")
+	}
+}
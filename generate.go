@@ -0,0 +1,89 @@
+package lists
+
+import (
+	"iter"
+)
+
+// Cycle returns an iterator repeating the elements of list forever. If list is empty, the iterator yields nothing; otherwise it must be bounded, for example with TakeSeq, before being collected.
+func Cycle[T any](list []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if len(list) == 0 {
+			return
+		}
+		for {
+			for _, v := range list {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterate returns the infinite iterator seed, f(seed), f(f(seed)), and so on. f is only called as elements are requested, so the iterator can be bounded with TakeSeq or TakeWhileSeq.
+func Iterate[T any](f func(T) T, seed T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := seed; yield(v); v = f(v) {
+		}
+	}
+}
+
+// IterateN returns a list holding the first n elements of Iterate(f, seed).
+func IterateN[T any](n int, f func(T) T, seed T) []T {
+	newList := make([]T, 0, max(n, 0))
+	for v := seed; len(newList) < n; {
+		newList = append(newList, v)
+		if len(newList) < n {
+			v = f(v)
+		}
+	}
+	return newList
+}
+
+// Naturals returns the infinite iterator 0, 1, 2, and so on, to be bounded with TakeSeq or TakeWhileSeq or zipped with a finite iterator.
+func Naturals() iter.Seq[int] {
+	return Iterate(func(i int) int { return i + 1 }, 0)
+}
+
+// Repeat returns an iterator yielding x forever. Use TakeSeq to bound it, or Duplicate for a list of n copies.
+func Repeat[T any](x T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for yield(x) {
+		}
+	}
+}
+
+// Repeatedly returns an iterator yielding the result of a new call to f for every element, forever. It is meant for functions with side effects, which are only called as elements are requested.
+func Repeatedly[T any](f func() T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for yield(f()) {
+		}
+	}
+}
+
+// Unfold returns an iterator built from seed, the dual of FoldL. f is called on the current state and must return the next element, the next state and true, or false to end the iterator. If f never returns false, the iterator is infinite.
+func Unfold[T any, S any](f func(S) (T, S, bool), seed S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for s := seed; ; {
+			v, next, ok := f(s)
+			if !ok || !yield(v) {
+				return
+			}
+			s = next
+		}
+	}
+}
+
+// UnfoldN returns a list holding at most the first n elements of Unfold(f, seed).
+func UnfoldN[T any, S any](n int, f func(S) (T, S, bool), seed S) []T {
+	newList := make([]T, 0)
+	for s := seed; len(newList) < n; {
+		v, next, ok := f(s)
+		if !ok {
+			break
+		}
+		newList = append(newList, v)
+		s = next
+	}
+	return newList
+}
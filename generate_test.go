@@ -0,0 +1,74 @@
+package lists
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCycle(t *testing.T) {
+	if !equal(slices.Collect(TakeSeq(5, Cycle([]int{1, 2}))), []int{1, 2, 1, 2, 1}) {
+		t.Error("TakeSeq(5, Cycle([]int{1, 2})) != []int{1, 2, 1, 2, 1}")
+	}
+	if len(slices.Collect(TakeSeq(5, Cycle([]int{})))) != 0 {
+		t.Error("TakeSeq(5, Cycle([]int{})) != []int{}")
+	}
+}
+
+func TestIterate(t *testing.T) {
+	if !equal(slices.Collect(TakeSeq(4, Iterate(func(x int) int { return x * 2 }, 1))), []int{1, 2, 4, 8}) {
+		t.Error("TakeSeq(4, Iterate(double, 1)) != []int{1, 2, 4, 8}")
+	}
+}
+
+func TestIterateN(t *testing.T) {
+	calls := 0
+	result := IterateN(3, func(x int) int { calls++; return x * 2 }, 1)
+	if !equal(result, []int{1, 2, 4}) || calls != 2 {
+		t.Error("IterateN(3, double, 1) != []int{1, 2, 4}")
+	}
+	if len(IterateN(-1, func(x int) int { return x }, 1)) != 0 {
+		t.Error("IterateN(-1, identity, 1) != []int{}")
+	}
+}
+
+func TestNaturals(t *testing.T) {
+	if !equal(slices.Collect(TakeWhileSeq(func(i int) bool { return i < 4 }, Naturals())), []int{0, 1, 2, 3}) {
+		t.Error("TakeWhileSeq(lessThan4, Naturals()) != []int{0, 1, 2, 3}")
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	if !equal(slices.Collect(TakeSeq(3, Repeat("a"))), []string{"a", "a", "a"}) {
+		t.Error(`TakeSeq(3, Repeat("a")) != []string{"a", "a", "a"}`)
+	}
+}
+
+func TestRepeatedly(t *testing.T) {
+	n := 0
+	if !equal(slices.Collect(TakeSeq(3, Repeatedly(func() int { n++; return n }))), []int{1, 2, 3}) || n != 3 {
+		t.Error("TakeSeq(3, Repeatedly(counter)) != []int{1, 2, 3}")
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	fib := func(s Pair[int, int]) (int, Pair[int, int], bool) {
+		return s.First, Pair[int, int]{s.Second, s.First + s.Second}, true
+	}
+	if !equal(slices.Collect(TakeSeq(7, Unfold(fib, Pair[int, int]{0, 1}))), []int{0, 1, 1, 2, 3, 5, 8}) {
+		t.Error("TakeSeq(7, Unfold(fib, {0, 1})) != []int{0, 1, 1, 2, 3, 5, 8}")
+	}
+	countdown := func(n int) (int, int, bool) { return n, n - 1, n > 0 }
+	if !equal(slices.Collect(Unfold(countdown, 3)), []int{3, 2, 1}) {
+		t.Error("Unfold(countdown, 3) != []int{3, 2, 1}")
+	}
+}
+
+func TestUnfoldN(t *testing.T) {
+	countdown := func(n int) (int, int, bool) { return n, n - 1, n > 0 }
+	if !equal(UnfoldN(2, countdown, 3), []int{3, 2}) {
+		t.Error("UnfoldN(2, countdown, 3) != []int{3, 2}")
+	}
+	if !equal(UnfoldN(10, countdown, 3), []int{3, 2, 1}) {
+		t.Error("UnfoldN(10, countdown, 3) != []int{3, 2, 1}")
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/hgisinger/lists
 
-go 1.18
+go 1.23
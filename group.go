@@ -0,0 +1,62 @@
+package lists
+
+// AggregateBy groups the elements of list by key(elem) and folds every group separately, as FoldL does, starting each group with acc.
+func AggregateBy[T any, K comparable, A any](key func(T) K, fun func(T, A) A, acc A, list []T) map[K]A {
+	groups := make(map[K]A)
+	for _, v := range list {
+		k := key(v)
+		a, ok := groups[k]
+		if !ok {
+			a = acc
+		}
+		groups[k] = fun(v, a)
+	}
+	return groups
+}
+
+// Associate returns a map with the key-value pairs returned by fun(elem) for every element of list. When several elements produce the same key, the last one wins.
+func Associate[T any, K comparable, V any](fun func(T) (K, V), list []T) map[K]V {
+	m := make(map[K]V, len(list))
+	for _, v := range list {
+		k, value := fun(v)
+		m[k] = value
+	}
+	return m
+}
+
+// CountBy returns a map from every distinct value of key(elem) to the number of elements of list producing it.
+func CountBy[T any, K comparable](key func(T) K, list []T) map[K]int {
+	return AggregateBy(key, func(_ T, n int) int { return n + 1 }, 0, list)
+}
+
+// GroupBy returns a map from every distinct value of key(elem) to the list of elements producing it. The elements of each group keep their order in list.
+func GroupBy[T any, K comparable](key func(T) K, list []T) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range list {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// GroupByOrdered is like GroupBy, but returns the groups as a list of key-group pairs in the order in which their keys first occur in list.
+func GroupByOrdered[T any, K comparable](key func(T) K, list []T) []Pair[K, []T] {
+	newList := make([]Pair[K, []T], 0)
+	index := make(map[K]int)
+	for _, v := range list {
+		k := key(v)
+		i, ok := index[k]
+		if !ok {
+			i = len(newList)
+			index[k] = i
+			newList = append(newList, Pair[K, []T]{First: k})
+		}
+		newList[i].Second = append(newList[i].Second, v)
+	}
+	return newList
+}
+
+// KeyBy returns a map from key(elem) to elem for every element of list. When several elements produce the same key, the last one wins.
+func KeyBy[T any, K comparable](key func(T) K, list []T) map[K]T {
+	return Associate(func(v T) (K, T) { return key(v), v }, list)
+}
@@ -0,0 +1,50 @@
+package lists
+
+import (
+	"testing"
+)
+
+func TestAggregateBy(t *testing.T) {
+	result := AggregateBy(userID, func(u user, acc string) string { return acc + u.name }, ">", users)
+	if len(result) != 3 || result[1] != ">annamy" || result[2] != ">bob" || result[3] != ">cid" {
+		t.Errorf("AggregateBy(userID, concatNames, \">\", users) = %v", result)
+	}
+}
+
+func TestAssociate(t *testing.T) {
+	result := Associate(func(u user) (string, int) { return u.name, u.id }, users)
+	if len(result) != 4 || result["ann"] != 1 || result["bob"] != 2 {
+		t.Errorf("Associate(nameToID, users) = %v", result)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	result := CountBy(userID, users)
+	if len(result) != 3 || result[1] != 2 || result[2] != 1 || result[3] != 1 {
+		t.Errorf("CountBy(userID, users) = %v, want map[1:2 2:1 3:1]", result)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	result := GroupBy(func(x int) bool { return x%2 == 0 }, []int{1, 2, 3, 4, 5})
+	if len(result) != 2 || !equal(result[true], []int{2, 4}) || !equal(result[false], []int{1, 3, 5}) {
+		t.Error("GroupBy(isEven, []int{1, 2, 3, 4, 5}) != map[bool][]int{true: {2, 4}, false: {1, 3, 5}}")
+	}
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	result := GroupByOrdered(userID, users)
+	if len(result) != 3 ||
+		result[0].First != 2 || !equal(result[0].Second, []user{{2, "bob"}}) ||
+		result[1].First != 1 || !equal(result[1].Second, []user{{1, "ann"}, {1, "amy"}}) ||
+		result[2].First != 3 || !equal(result[2].Second, []user{{3, "cid"}}) {
+		t.Errorf("GroupByOrdered(userID, users) = %v", result)
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	result := KeyBy(userID, users)
+	if len(result) != 3 || result[1].name != "amy" || result[2].name != "bob" {
+		t.Errorf("KeyBy(userID, users) = %v", result)
+	}
+}
@@ -0,0 +1,72 @@
+package lists
+
+// Count returns the number of elements in list matching t.
+func Count[T comparable](t T, list []T) int {
+	return CountFunc(func(v T) bool { return v == t }, list)
+}
+
+// CountFunc returns the number of elements in list for which pred(elem) returns true. The pred function must return a boolean.
+func CountFunc[T any](pred func(T) bool, list []T) int {
+	n := 0
+	for _, v := range list {
+		if pred(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// FindLast returns the last value in list such that pred(value) returns true. The pred function must return a boolean.
+func FindLast[T any](pred func(T) bool, list []T) (T, bool) {
+	if i, ok := LastIndexFunc(pred, list); ok {
+		return list[i], true
+	}
+	var empty T
+	return empty, false
+}
+
+// Index returns the position of the first element in list matching t, if there is such an element.
+func Index[T comparable](t T, list []T) (int, bool) {
+	return IndexFunc(func(v T) bool { return v == t }, list)
+}
+
+// IndexFunc returns the position of the first element in list for which pred(elem) returns true, if there is such an element. The pred function must return a boolean.
+func IndexFunc[T any](pred func(T) bool, list []T) (int, bool) {
+	for i, v := range list {
+		if pred(v) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Indices returns the positions of all elements in list matching t, in increasing order.
+func Indices[T comparable](t T, list []T) []int {
+	return IndicesFunc(func(v T) bool { return v == t }, list)
+}
+
+// IndicesFunc returns the positions of all elements in list for which pred(elem) returns true, in increasing order. The pred function must return a boolean.
+func IndicesFunc[T any](pred func(T) bool, list []T) []int {
+	newList := make([]int, 0)
+	for i, v := range list {
+		if pred(v) {
+			newList = append(newList, i)
+		}
+	}
+	return newList
+}
+
+// LastIndex returns the position of the last element in list matching t, if there is such an element.
+func LastIndex[T comparable](t T, list []T) (int, bool) {
+	return LastIndexFunc(func(v T) bool { return v == t }, list)
+}
+
+// LastIndexFunc returns the position of the last element in list for which pred(elem) returns true, if there is such an element. The pred function must return a boolean.
+func LastIndexFunc[T any](pred func(T) bool, list []T) (int, bool) {
+	for i := len(list) - 1; i >= 0; i-- {
+		if pred(list[i]) {
+			return i, true
+		}
+	}
+	return -1, false
+}
@@ -0,0 +1,76 @@
+package lists
+
+import (
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	if Count(2, []int{2, 1, 2, 3}) != 2 {
+		t.Error("Count(2, []int{2, 1, 2, 3}) != 2")
+	}
+}
+
+func TestCountFunc(t *testing.T) {
+	if CountFunc(func(x int) bool { return x > 1 }, []int{2, 1, 2, 3}) != 3 {
+		t.Error("CountFunc(func(x int) bool { return x > 1 }, []int{2, 1, 2, 3}) != 3")
+	}
+}
+
+func TestFindLast(t *testing.T) {
+	v, ok := FindLast(func(x int) bool { return x < 3 }, []int{1, 2, 3, 4})
+	if !ok || v != 2 {
+		t.Error("FindLast(func(x int) bool { return x < 3 }, []int{1, 2, 3, 4}) != 2")
+	}
+	_, ok = FindLast(func(x int) bool { return x > 4 }, []int{1, 2, 3, 4})
+	if ok {
+		t.Error("FindLast(func(x int) bool { return x > 4 }, []int{1, 2, 3, 4}) != false")
+	}
+}
+
+func TestIndex(t *testing.T) {
+	i, ok := Index("b", []string{"a", "b", "b"})
+	if !ok || i != 1 {
+		t.Error(`Index("b", []string{"a", "b", "b"}) != 1`)
+	}
+	_, ok = Index("c", []string{"a", "b", "b"})
+	if ok {
+		t.Error(`Index("c", []string{"a", "b", "b"}) != false`)
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	i, ok := IndexFunc(func(x int) bool { return x > 1 }, []int{1, 2, 3})
+	if !ok || i != 1 {
+		t.Error("IndexFunc(func(x int) bool { return x > 1 }, []int{1, 2, 3}) != 1")
+	}
+}
+
+func TestIndices(t *testing.T) {
+	if !equal(Indices(1, []int{1, 2, 1, 1}), []int{0, 2, 3}) {
+		t.Error("Indices(1, []int{1, 2, 1, 1}) != []int{0, 2, 3}")
+	}
+}
+
+func TestIndicesFunc(t *testing.T) {
+	if !equal(IndicesFunc(func(x int) bool { return x%2 == 0 }, []int{1, 2, 3, 4}), []int{1, 3}) {
+		t.Error("IndicesFunc(isEven, []int{1, 2, 3, 4}) != []int{1, 3}")
+	}
+}
+
+func TestLastIndex(t *testing.T) {
+	i, ok := LastIndex("b", []string{"a", "b", "b"})
+	if !ok || i != 2 {
+		t.Error(`LastIndex("b", []string{"a", "b", "b"}) != 2`)
+	}
+}
+
+func TestLastIndexFunc(t *testing.T) {
+	i, ok := LastIndexFunc(func(x int) bool { return x < 3 }, []int{1, 2, 3})
+	if !ok || i != 1 {
+		t.Error("LastIndexFunc(func(x int) bool { return x < 3 }, []int{1, 2, 3}) != 1")
+	}
+	_, ok = LastIndexFunc(func(x int) bool { return x < 3 }, []int{})
+	if ok {
+		t.Error("LastIndexFunc(func(x int) bool { return x < 3 }, []int{}) != false")
+	}
+}
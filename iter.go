@@ -0,0 +1,155 @@
+package lists
+
+import (
+	"context"
+	"iter"
+)
+
+// ChanSeq returns an iterator over the values received from ch, which ends when ch is closed. Unlike the other Seq iterators it consumes its input, so it can be ranged over only once.
+func ChanSeq[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhileSeq returns an iterator that drops elements from seq while pred(elem) returns true and then yields the remaining elements. It can be ranged over as many times as seq.
+func DropWhileSeq[T any](pred func(T) bool, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for v := range seq {
+			if dropping && pred(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// EnumerateSeq returns an iterator over the positions and elements of seq, counting from 0. The count restarts every time the iterator is ranged over.
+func EnumerateSeq[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// FilterSeq returns an iterator over the elements of seq for which pred(elem) returns true. pred is only called as the iterator is ranged over, so that FilterSeq composes with slices.Values and slices.Collect without building intermediate lists.
+func FilterSeq[T any](pred func(T) bool, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FoldLSeq calls fun(elem, acc) on successive elements of seq, starting with acc, and returns the final value of the accumulator. seq must be finite.
+func FoldLSeq[T any, A any](fun func(T, A) A, acc A, seq iter.Seq[T]) A {
+	for v := range seq {
+		acc = fun(v, acc)
+	}
+	return acc
+}
+
+// MapSeq returns an iterator over fun(elem) for every element of seq. fun is called lazily, each time the iterator is ranged over, and its results are not kept.
+func MapSeq[T any, U any](fun func(T) U, seq iter.Seq[T]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(fun(v)) {
+				return
+			}
+		}
+	}
+}
+
+// PairSeq returns an iterator over the key-value pairs of seq as Pair values, so that for example PairSeq(maps.All(m)) can be collected with slices.Collect.
+func PairSeq[K any, V any](seq iter.Seq2[K, V]) iter.Seq[Pair[K, V]] {
+	return func(yield func(Pair[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(Pair[K, V]{k, v}) {
+				return
+			}
+		}
+	}
+}
+
+// SeqChan returns a channel receiving the elements of seq, which is closed once seq is exhausted or ctx is done. A goroutine iterates over seq until then, so the channel must be drained or ctx cancelled to release it.
+func SeqChan[T any](ctx context.Context, seq iter.Seq[T]) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for v := range seq {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// TakeSeq returns an iterator over at most the first n elements of seq. It is the way to bound an infinite iterator.
+func TakeSeq[T any](n int, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			i++
+			if !yield(v) || i == n {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhileSeq returns an iterator over the elements of seq while pred(elem) returns true.
+func TakeWhileSeq[T any](pred func(T) bool, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !pred(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// UnpairSeq returns an iterator over the First and Second fields of the pairs of seq as key-value pairs. It is the inverse of PairSeq.
+func UnpairSeq[K any, V any](seq iter.Seq[Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := range seq {
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
+
+// ZipSeq returns an iterator over pairs of corresponding elements of seq1 and seq2, which ends as soon as one of them is exhausted. seq2 is pulled in step with seq1, so neither is read ahead.
+func ZipSeq[A any, B any](seq1 iter.Seq[A], seq2 iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(seq2)
+		defer stop()
+		for a := range seq1 {
+			b, ok := next()
+			if !ok || !yield(a, b) {
+				return
+			}
+		}
+	}
+}
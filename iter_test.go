@@ -0,0 +1,124 @@
+package lists
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestChanSeq(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	if !equal(slices.Collect(ChanSeq(ch)), []int{1, 2, 3}) {
+		t.Error("slices.Collect(ChanSeq(ch)) != []int{1, 2, 3}")
+	}
+}
+
+func TestDropWhileSeq(t *testing.T) {
+	result := slices.Collect(DropWhileSeq(func(x int) bool { return x < 3 }, slices.Values([]int{1, 2, 3, 1})))
+	if !equal(result, []int{3, 1}) {
+		t.Error("DropWhileSeq(func(x int) bool { return x < 3 }, []int{1, 2, 3, 1}) != []int{3, 1}")
+	}
+}
+
+func TestEnumerateSeq(t *testing.T) {
+	var result []string
+	for i, v := range EnumerateSeq(slices.Values([]string{"a", "b"})) {
+		result = append(result, strconv.Itoa(i)+v)
+	}
+	if !equal(result, []string{"0a", "1b"}) {
+		t.Error(`EnumerateSeq([]string{"a", "b"}) != (0, "a"), (1, "b")`)
+	}
+}
+
+func TestFilterSeq(t *testing.T) {
+	result := slices.Collect(FilterSeq(func(x int) bool { return x%2 != 0 }, slices.Values([]int{1, 2, 3, 4, 5})))
+	if !equal(result, []int{1, 3, 5}) {
+		t.Error("FilterSeq(isOdd, []int{1, 2, 3, 4, 5}) != []int{1, 3, 5}")
+	}
+}
+
+func TestFoldLSeq(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	if FoldLSeq(func(x, acc int) int { return x + acc }, 0, maps.Values(m)) != 6 {
+		t.Error("FoldLSeq(sum, 0, maps.Values(m)) != 6")
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	result := slices.Collect(MapSeq(strconv.Itoa, slices.Values([]int{1, 2, 3})))
+	if !equal(result, []string{"1", "2", "3"}) {
+		t.Error(`MapSeq(strconv.Itoa, []int{1, 2, 3}) != []string{"1", "2", "3"}`)
+	}
+}
+
+func TestPairSeq(t *testing.T) {
+	result := SortFunc(func(a, b Pair[string, int]) int { return compare(a.First, b.First) },
+		slices.Collect(PairSeq(maps.All(map[string]int{"b": 2, "a": 1}))))
+	if !equal(result, []Pair[string, int]{{"a", 1}, {"b", 2}}) {
+		t.Error(`PairSeq(maps.All(map[string]int{"b": 2, "a": 1})) != []Pair[string, int]{{"a", 1}, {"b", 2}}`)
+	}
+}
+
+func TestSeqChan(t *testing.T) {
+	var result []int
+	for v := range SeqChan(context.Background(), slices.Values([]int{1, 2, 3})) {
+		result = append(result, v)
+	}
+	if !equal(result, []int{1, 2, 3}) {
+		t.Error("SeqChan(ctx, []int{1, 2, 3}) != []int{1, 2, 3}")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := SeqChan(ctx, slices.Values([]int{1, 2, 3}))
+	<-ch
+	cancel()
+	for range ch {
+	}
+}
+
+func TestTakeSeq(t *testing.T) {
+	pulled := 0
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	if !equal(slices.Collect(TakeSeq(3, naturals)), []int{0, 1, 2}) || pulled != 3 {
+		t.Error("TakeSeq(3, naturals) != []int{0, 1, 2}")
+	}
+	if len(slices.Collect(TakeSeq(0, naturals))) != 0 {
+		t.Error("TakeSeq(0, naturals) != []int{}")
+	}
+}
+
+func TestTakeWhileSeq(t *testing.T) {
+	result := slices.Collect(TakeWhileSeq(func(x int) bool { return x < 3 }, slices.Values([]int{1, 2, 3, 1})))
+	if !equal(result, []int{1, 2}) {
+		t.Error("TakeWhileSeq(func(x int) bool { return x < 3 }, []int{1, 2, 3, 1}) != []int{1, 2}")
+	}
+}
+
+func TestUnpairSeq(t *testing.T) {
+	m := maps.Collect(UnpairSeq(slices.Values([]Pair[string, int]{{"a", 1}, {"b", 2}})))
+	if len(m) != 2 || m["a"] != 1 || m["b"] != 2 {
+		t.Error(`maps.Collect(UnpairSeq([]Pair[string, int]{{"a", 1}, {"b", 2}})) != map[string]int{"a": 1, "b": 2}`)
+	}
+}
+
+func TestZipSeq(t *testing.T) {
+	var result []string
+	for a, b := range ZipSeq(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a", "b"})) {
+		result = append(result, strconv.Itoa(a)+b)
+	}
+	if !equal(result, []string{"1a", "2b"}) {
+		t.Error(`ZipSeq([]int{1, 2, 3}, []string{"a", "b"}) != (1, "a"), (2, "b")`)
+	}
+}
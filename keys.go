@@ -0,0 +1,91 @@
+package lists
+
+import (
+	"constraints"
+)
+
+// KeyDelete returns a copy of list where the first element whose key(elem) equals k is deleted, if there is such an element.
+func KeyDelete[T any, K comparable](k K, key func(T) K, list []T) []T {
+	newList := make([]T, 0, len(list))
+	for i, v := range list {
+		if key(v) == k {
+			return append(newList, list[i+1:]...)
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// KeyFind searches list for an element whose key(elem) equals k. Returns the first such element and true if found, otherwise false.
+func KeyFind[T any, K comparable](k K, key func(T) K, list []T) (T, bool) {
+	return Search(func(v T) bool { return key(v) == k }, list)
+}
+
+// KeyMap returns a list where the key of each element in list has been replaced with the result of calling fun on it. set must return a copy of its first argument with its key replaced by the second argument.
+func KeyMap[T any, K any](fun func(K) K, key func(T) K, set func(T, K) T, list []T) []T {
+	return Map(func(v T) T { return set(v, fun(key(v))) }, list)
+}
+
+// KeyMember returns true if there is an element in list whose key(elem) equals k, otherwise false.
+func KeyMember[T any, K comparable](k K, key func(T) K, list []T) bool {
+	return Any(func(v T) bool { return key(v) == k }, list)
+}
+
+// KeyMerge returns the sorted list formed by merging all the sublists. All sublists must be sorted by key before evaluating this function. When the keys of two elements compare equal, the element from the sublist with the lowest position is picked before the other element.
+func KeyMerge[T any, K constraints.Ordered](key func(T) K, lists ...[]T) []T {
+	return merge(byKey(key), false, lists)
+}
+
+// KeyReplace returns a copy of list where the first element whose key(elem) equals k is replaced with t, if there is such an element.
+func KeyReplace[T any, K comparable](k K, key func(T) K, list []T, t T) []T {
+	newList := make([]T, len(list))
+	copy(newList, list)
+	for i, v := range list {
+		if key(v) == k {
+			newList[i] = t
+			break
+		}
+	}
+	return newList
+}
+
+// KeySort returns a list containing the elements of list sorted by key(elem). The sort is stable: elements whose keys compare equal keep their relative order.
+func KeySort[T any, K constraints.Ordered](key func(T) K, list []T) []T {
+	return SortFunc(byKey(key), list)
+}
+
+// KeyStore returns a copy of list where the first element whose key(elem) equals k is replaced with t. If there is no such element, t is appended to the end of the list.
+func KeyStore[T any, K comparable](k K, key func(T) K, list []T, t T) []T {
+	newList := make([]T, len(list), len(list)+1)
+	copy(newList, list)
+	for i, v := range list {
+		if key(v) == k {
+			newList[i] = t
+			return newList
+		}
+	}
+	return append(newList, t)
+}
+
+// KeyTake searches list for an element whose key(elem) equals k. Returns the first such element, the copy of list KeyDelete returns and true if found, otherwise false and a copy of list.
+func KeyTake[T any, K comparable](k K, key func(T) K, list []T) (T, []T, bool) {
+	v, ok := KeyFind(k, key, list)
+	return v, KeyDelete(k, key, list), ok
+}
+
+// UKeyMerge is like KeyMerge, but all sublists must contain no elements with equal keys. When the keys of two elements compare equal, the element from the sublist with the lowest position is picked and the other is deleted.
+func UKeyMerge[T any, K constraints.Ordered](key func(T) K, lists ...[]T) []T {
+	return merge(byKey(key), true, lists)
+}
+
+// UKeySort returns a list containing the elements of list sorted by key(elem), where all except the first element of the elements whose keys compare equal have been deleted.
+func UKeySort[T any, K constraints.Ordered](key func(T) K, list []T) []T {
+	return USortFunc(byKey(key), list)
+}
+
+// byKey returns a comparison function ordering elements by key(elem).
+func byKey[T any, K constraints.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return compare(key(a), key(b))
+	}
+}
@@ -0,0 +1,123 @@
+package lists
+
+import (
+	"testing"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+func userID(u user) int { return u.id }
+
+var users = []user{{2, "bob"}, {1, "ann"}, {3, "cid"}, {1, "amy"}}
+
+func TestKeyDelete(t *testing.T) {
+	result := KeyDelete(1, userID, users)
+	if !equal(result, []user{{2, "bob"}, {3, "cid"}, {1, "amy"}}) {
+		t.Error(`KeyDelete(1, userID, users) != []user{{2, "bob"}, {3, "cid"}, {1, "amy"}}`)
+	}
+	if users[1].name != "ann" {
+		t.Error("KeyDelete(1, userID, users) modified its argument")
+	}
+	if !equal(KeyDelete(9, userID, users), users) {
+		t.Error("KeyDelete(9, userID, users) != users")
+	}
+}
+
+func TestKeyFind(t *testing.T) {
+	u, ok := KeyFind(1, userID, users)
+	if !ok || u.name != "ann" {
+		t.Error(`KeyFind(1, userID, users) != user{1, "ann"}`)
+	}
+	_, ok = KeyFind(9, userID, users)
+	if ok {
+		t.Error("KeyFind(9, userID, users) != false")
+	}
+}
+
+func TestKeyMap(t *testing.T) {
+	result := KeyMap(func(id int) int { return id * 10 }, userID, func(u user, id int) user { u.id = id; return u }, users)
+	if !equal(result, []user{{20, "bob"}, {10, "ann"}, {30, "cid"}, {10, "amy"}}) {
+		t.Error(`KeyMap(times10, userID, setID, users) != []user{{20, "bob"}, {10, "ann"}, {30, "cid"}, {10, "amy"}}`)
+	}
+}
+
+func TestKeyMember(t *testing.T) {
+	if !KeyMember(3, userID, users) {
+		t.Error("KeyMember(3, userID, users) != true")
+	}
+	if KeyMember(4, userID, users) {
+		t.Error("KeyMember(4, userID, users) != false")
+	}
+}
+
+func TestKeyMerge(t *testing.T) {
+	result := KeyMerge(userID, []user{{1, "ann"}, {3, "cid"}}, []user{{1, "amy"}, {2, "bob"}})
+	if !equal(result, []user{{1, "ann"}, {1, "amy"}, {2, "bob"}, {3, "cid"}}) {
+		t.Error(`KeyMerge(userID, ...) != []user{{1, "ann"}, {1, "amy"}, {2, "bob"}, {3, "cid"}}`)
+	}
+}
+
+func TestKeyReplace(t *testing.T) {
+	result := KeyReplace(1, userID, users, user{1, "al"})
+	if !equal(result, []user{{2, "bob"}, {1, "al"}, {3, "cid"}, {1, "amy"}}) {
+		t.Error(`KeyReplace(1, userID, users, user{1, "al"}) != []user{{2, "bob"}, {1, "al"}, {3, "cid"}, {1, "amy"}}`)
+	}
+	if users[1].name != "ann" {
+		t.Error("KeyReplace(1, userID, users, user{1, \"al\"}) modified its argument")
+	}
+}
+
+func TestKeySort(t *testing.T) {
+	result := KeySort(userID, users)
+	if !equal(result, []user{{1, "ann"}, {1, "amy"}, {2, "bob"}, {3, "cid"}}) {
+		t.Error(`KeySort(userID, users) != []user{{1, "ann"}, {1, "amy"}, {2, "bob"}, {3, "cid"}}`)
+	}
+}
+
+func TestKeyStore(t *testing.T) {
+	result := KeyStore(3, userID, users, user{3, "cy"})
+	if !equal(result, []user{{2, "bob"}, {1, "ann"}, {3, "cy"}, {1, "amy"}}) {
+		t.Error(`KeyStore(3, userID, users, user{3, "cy"}) != []user{{2, "bob"}, {1, "ann"}, {3, "cy"}, {1, "amy"}}`)
+	}
+	result = KeyStore(4, userID, users, user{4, "dan"})
+	if !equal(result, []user{{2, "bob"}, {1, "ann"}, {3, "cid"}, {1, "amy"}, {4, "dan"}}) {
+		t.Error(`KeyStore(4, userID, users, user{4, "dan"}) != []user{{2, "bob"}, {1, "ann"}, {3, "cid"}, {1, "amy"}, {4, "dan"}}`)
+	}
+}
+
+func TestKeyTake(t *testing.T) {
+	u, rest, ok := KeyTake(1, userID, users)
+	if !ok || u.name != "ann" || !equal(rest, []user{{2, "bob"}, {3, "cid"}, {1, "amy"}}) {
+		t.Error(`KeyTake(1, userID, users) != user{1, "ann"}, []user{{2, "bob"}, {3, "cid"}, {1, "amy"}}`)
+	}
+	list := []user{{1, "ann"}, {2, "bob"}}
+	_, rest, ok = KeyTake(9, userID, list[:1])
+	if ok || !equal(rest, []user{{1, "ann"}}) {
+		t.Error(`KeyTake(9, userID, []user{{1, "ann"}}) != []user{{1, "ann"}}, false`)
+	}
+	rest = append(rest, user{3, "cid"})
+	if list[1] != (user{2, "bob"}) {
+		t.Error("KeyTake(9, userID, list) returned list itself instead of a copy")
+	}
+	_, rest, _ = KeyTake(1, userID, list[:1])
+	if rest == nil || len(rest) != 0 {
+		t.Error(`KeyTake(1, userID, []user{{1, "ann"}}) != []user{}`)
+	}
+}
+
+func TestUKeyMerge(t *testing.T) {
+	result := UKeyMerge(userID, []user{{1, "ann"}, {3, "cid"}}, []user{{1, "amy"}, {2, "bob"}})
+	if !equal(result, []user{{1, "ann"}, {2, "bob"}, {3, "cid"}}) {
+		t.Error(`UKeyMerge(userID, ...) != []user{{1, "ann"}, {2, "bob"}, {3, "cid"}}`)
+	}
+}
+
+func TestUKeySort(t *testing.T) {
+	result := UKeySort(userID, users)
+	if !equal(result, []user{{1, "ann"}, {2, "bob"}, {3, "cid"}}) {
+		t.Error(`UKeySort(userID, users) != []user{{1, "ann"}, {2, "bob"}, {3, "cid"}}`)
+	}
+}
@@ -0,0 +1,192 @@
+// Package lazy provides pull-based lazy streams mirroring the functions of package lists. Every stage of a pipeline is evaluated on demand, one element at a time, so that no intermediate slice is allocated and only the elements actually consumed are computed.
+package lazy
+
+import (
+	"iter"
+)
+
+// Stream is a lazy sequence of values. Each call returns the next value and true, or false once the stream is exhausted. A Stream can only be consumed once.
+type Stream[T any] func() (T, bool)
+
+// All returns true if pred(elem) returns true for all elements in stream, otherwise false. The stream is consumed up to the first element for which pred returns false.
+func All[T any](pred func(T) bool, stream Stream[T]) bool {
+	for v, ok := stream(); ok; v, ok = stream() {
+		if !pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any returns true if pred(elem) returns true for at least one element in stream, otherwise false. The stream is consumed up to the first element for which pred returns true.
+func Any[T any](pred func(T) bool, stream Stream[T]) bool {
+	for v, ok := stream(); ok; v, ok = stream() {
+		if pred(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// DropWhile returns a stream that drops elements from stream while pred(elem) returns true and then yields the remaining elements.
+func DropWhile[T any](pred func(T) bool, stream Stream[T]) Stream[T] {
+	dropping := true
+	return func() (T, bool) {
+		for v, ok := stream(); ok; v, ok = stream() {
+			if !dropping || !pred(v) {
+				dropping = false
+				return v, true
+			}
+		}
+		var empty T
+		return empty, false
+	}
+}
+
+// Empty returns a stream without elements.
+func Empty[T any]() Stream[T] {
+	return func() (T, bool) {
+		var empty T
+		return empty, false
+	}
+}
+
+// Filter returns a stream of all elements in stream for which pred(elem) returns true.
+func Filter[T any](pred func(T) bool, stream Stream[T]) Stream[T] {
+	return func() (T, bool) {
+		for v, ok := stream(); ok; v, ok = stream() {
+			if pred(v) {
+				return v, true
+			}
+		}
+		var empty T
+		return empty, false
+	}
+}
+
+// FilterMap returns a stream calling fun(elem) on successive elements of stream and yielding the new value for which fun returns true.
+func FilterMap[T any](fun func(T) (bool, T), stream Stream[T]) Stream[T] {
+	return func() (T, bool) {
+		for v, ok := stream(); ok; v, ok = stream() {
+			if ok, value := fun(v); ok {
+				return value, true
+			}
+		}
+		var empty T
+		return empty, false
+	}
+}
+
+// FlatMap returns a stream yielding the elements of fun(elem) for every element in stream, in order.
+func FlatMap[T any, U any](fun func(T) []U, stream Stream[T]) Stream[U] {
+	var pending []U
+	return func() (U, bool) {
+		for len(pending) == 0 {
+			v, ok := stream()
+			if !ok {
+				var empty U
+				return empty, false
+			}
+			pending = fun(v)
+		}
+		u := pending[0]
+		pending = pending[1:]
+		return u, true
+	}
+}
+
+// FoldL calls fun(elem, acc) on successive elements of stream, starting with acc, and returns the final value of the accumulator. The whole stream is consumed, so it must be finite.
+func FoldL[T any, A any](fun func(T, A) A, acc A, stream Stream[T]) A {
+	for v, ok := stream(); ok; v, ok = stream() {
+		acc = fun(v, acc)
+	}
+	return acc
+}
+
+// FromSlice returns a stream yielding the elements of list in order. The list is not copied.
+func FromSlice[T any](list []T) Stream[T] {
+	i := 0
+	return func() (T, bool) {
+		if i >= len(list) {
+			var empty T
+			return empty, false
+		}
+		i++
+		return list[i-1], true
+	}
+}
+
+// Map returns a stream yielding fun(elem) for every element in stream.
+func Map[T any, U any](fun func(T) U, stream Stream[T]) Stream[U] {
+	return func() (U, bool) {
+		v, ok := stream()
+		if !ok {
+			var empty U
+			return empty, false
+		}
+		return fun(v), true
+	}
+}
+
+// Search returns the first value in stream such that pred(value) returns true. The stream is consumed up to that value.
+func Search[T any](pred func(T) bool, stream Stream[T]) (T, bool) {
+	for v, ok := stream(); ok; v, ok = stream() {
+		if pred(v) {
+			return v, true
+		}
+	}
+	var empty T
+	return empty, false
+}
+
+// Split returns the first n elements of stream as a list, and a stream of the remaining elements.
+func Split[T any](n int, stream Stream[T]) ([]T, Stream[T]) {
+	return ToSlice(Take(n, stream)), stream
+}
+
+// Take returns a stream yielding at most the first n elements of stream. It is the way to bound an infinite stream.
+func Take[T any](n int, stream Stream[T]) Stream[T] {
+	return func() (T, bool) {
+		if n <= 0 {
+			var empty T
+			return empty, false
+		}
+		n--
+		return stream()
+	}
+}
+
+// TakeWhile returns a stream yielding elements from stream while pred(elem) returns true.
+func TakeWhile[T any](pred func(T) bool, stream Stream[T]) Stream[T] {
+	done := false
+	return func() (T, bool) {
+		if !done {
+			if v, ok := stream(); ok && pred(v) {
+				return v, true
+			}
+			done = true
+		}
+		var empty T
+		return empty, false
+	}
+}
+
+// ToSeq returns an iterator over the remaining elements of stream, so that it can be used in range loops and with slices.Collect. Since a Stream can only be consumed once, so can the iterator.
+func ToSeq[T any](stream Stream[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, ok := stream(); ok; v, ok = stream() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice consumes stream and returns its elements as a list. The stream must be finite.
+func ToSlice[T any](stream Stream[T]) []T {
+	newList := make([]T, 0)
+	for v, ok := stream(); ok; v, ok = stream() {
+		newList = append(newList, v)
+	}
+	return newList
+}
@@ -0,0 +1,161 @@
+package lazy
+
+import (
+	"strconv"
+	"testing"
+)
+
+// equal reports whether list1 and list2 hold the same elements in the same order.
+func equal[T comparable](list1, list2 []T) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if list1[i] != list2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// counting returns a stream of the integers from 1 up to n, together with a pointer to the number of elements pulled so far.
+func counting(n int) (Stream[int], *int) {
+	pulled := 0
+	return func() (int, bool) {
+		if pulled >= n {
+			return 0, false
+		}
+		pulled++
+		return pulled, true
+	}, &pulled
+}
+
+func TestAll(t *testing.T) {
+	s, pulled := counting(10)
+	if All(func(x int) bool { return x < 3 }, s) || *pulled != 3 {
+		t.Error("All(func(x int) bool { return x < 3 }, 1..10) != false after 3 elements")
+	}
+}
+
+func TestAny(t *testing.T) {
+	s, pulled := counting(10)
+	if !Any(func(x int) bool { return x == 2 }, s) || *pulled != 2 {
+		t.Error("Any(func(x int) bool { return x == 2 }, 1..10) != true after 2 elements")
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	result := ToSlice(DropWhile(func(x int) bool { return x < 3 }, FromSlice([]int{1, 2, 3, 1, 4})))
+	if !equal(result, []int{3, 1, 4}) {
+		t.Error("DropWhile(func(x int) bool { return x < 3 }, []int{1, 2, 3, 1, 4}) != []int{3, 1, 4}")
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	if len(ToSlice(Empty[int]())) != 0 {
+		t.Error("Empty() is not empty")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	result := ToSlice(Filter(func(x int) bool { return x%2 != 0 }, FromSlice([]int{1, 2, 3, 4, 5})))
+	if !equal(result, []int{1, 3, 5}) {
+		t.Error("Filter(isOdd, []int{1, 2, 3, 4, 5}) != []int{1, 3, 5}")
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	result := ToSlice(FilterMap(func(x int) (bool, int) { return x%2 != 0, x * 2 }, FromSlice([]int{1, 2, 3})))
+	if !equal(result, []int{2, 6}) {
+		t.Error("FilterMap(doubleOdd, []int{1, 2, 3}) != []int{2, 6}")
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	result := ToSlice(FlatMap(func(x int) []int { return make([]int, x) }, FromSlice([]int{1, 0, 2})))
+	if !equal(result, []int{0, 0, 0}) {
+		t.Error("FlatMap(zeros, []int{1, 0, 2}) != []int{0, 0, 0}")
+	}
+}
+
+func TestFoldL(t *testing.T) {
+	result := FoldL(func(x int, acc string) string { return acc + strconv.Itoa(x) }, "", FromSlice([]int{1, 2, 3}))
+	if result != "123" {
+		t.Error(`FoldL(concat, "", []int{1, 2, 3}) != "123"`)
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	if !equal(ToSlice(FromSlice([]int{1, 2, 3})), []int{1, 2, 3}) {
+		t.Error("ToSlice(FromSlice([]int{1, 2, 3})) != []int{1, 2, 3}")
+	}
+}
+
+func TestMap(t *testing.T) {
+	if !equal(ToSlice(Map(strconv.Itoa, FromSlice([]int{1, 2, 3}))), []string{"1", "2", "3"}) {
+		t.Error(`Map(strconv.Itoa, []int{1, 2, 3}) != []string{"1", "2", "3"}`)
+	}
+}
+
+func TestPipelineIsLazy(t *testing.T) {
+	s, pulled := counting(1000000)
+	calls := 0
+	result := ToSlice(Take(3, Map(func(x int) int {
+		calls++
+		return x * x
+	}, Filter(func(x int) bool { return x%2 == 0 }, s))))
+	if !equal(result, []int{4, 16, 36}) || *pulled != 6 || calls != 3 {
+		t.Errorf("Take(3, Map(square, Filter(isEven, 1..1000000))) = %v after pulling %d elements and %d calls", result, *pulled, calls)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	v, ok := Search(func(x int) bool { return x > 2 }, FromSlice([]int{1, 2, 3, 4}))
+	if !ok || v != 3 {
+		t.Error("Search(func(x int) bool { return x > 2 }, []int{1, 2, 3, 4}) != 3")
+	}
+	_, ok = Search(func(x int) bool { return x > 4 }, FromSlice([]int{1, 2, 3, 4}))
+	if ok {
+		t.Error("Search(func(x int) bool { return x > 4 }, []int{1, 2, 3, 4}) != false")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	head, tail := Split(2, FromSlice([]int{1, 2, 3, 4}))
+	if !equal(head, []int{1, 2}) || !equal(ToSlice(tail), []int{3, 4}) {
+		t.Error("Split(2, []int{1, 2, 3, 4}) != []int{1, 2}, []int{3, 4}")
+	}
+}
+
+func TestTake(t *testing.T) {
+	s, pulled := counting(10)
+	if !equal(ToSlice(Take(2, s)), []int{1, 2}) || *pulled != 2 {
+		t.Error("Take(2, 1..10) != []int{1, 2}")
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	s, pulled := counting(10)
+	if !equal(ToSlice(TakeWhile(func(x int) bool { return x < 3 }, s)), []int{1, 2}) || *pulled != 3 {
+		t.Error("TakeWhile(func(x int) bool { return x < 3 }, 1..10) != []int{1, 2}")
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	if s := ToSlice(FromSlice([]int{})); s == nil || len(s) != 0 {
+		t.Error("ToSlice(FromSlice([]int{})) != []int{}")
+	}
+}
+
+func TestToSeq(t *testing.T) {
+	var result []int
+	for v := range ToSeq(Map(func(x int) int { return x * 10 }, FromSlice([]int{1, 2, 3}))) {
+		if v > 20 {
+			break
+		}
+		result = append(result, v)
+	}
+	if !equal(result, []int{10, 20}) {
+		t.Error("range ToSeq(Map(times10, []int{1, 2, 3})) with break != []int{10, 20}")
+	}
+}
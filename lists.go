@@ -110,22 +110,44 @@ func Flatten[T any](lists [][]T) []T {
 	return newList
 }
 
-// FoldL calls fun(t, acc) on successive elements list, starting with acc. fun must return a new accumulator, which is passed to the next call. The function returns the final value of the accumulator.
-func FoldL[T any](fun func(T, T) T, acc T, list []T) T {
+// FoldL calls fun(t, acc) on successive elements list, starting with acc. fun must return a new accumulator, which is passed to the next call. The function returns the final value of the accumulator. The accumulator may be of a different type than the elements.
+func FoldL[T any, A any](fun func(T, A) A, acc A, list []T) A {
 	for _, v := range list {
 		acc = fun(v, acc)
 	}
 	return acc
 }
 
+// FoldLWhile is like FoldL, but fun must also return a boolean. The fold continues with the next element while fun returns true and halts as soon as it returns false. The function returns the last accumulator returned by fun, or acc if list is empty.
+func FoldLWhile[T any, A any](fun func(T, A) (A, bool), acc A, list []T) A {
+	for _, v := range list {
+		var cont bool
+		if acc, cont = fun(v, acc); !cont {
+			break
+		}
+	}
+	return acc
+}
+
 // FoldR is like FoldL, but the list is traversed from right to left.
-func FoldR[T any](f func(T, T) T, acc T, list []T) T {
+func FoldR[T any, A any](f func(T, A) A, acc A, list []T) A {
 	for i := len(list) - 1; i >= 0; i-- {
 		acc = f(list[i], acc)
 	}
 	return acc
 }
 
+// FoldRWhile is like FoldLWhile, but the list is traversed from right to left.
+func FoldRWhile[T any, A any](fun func(T, A) (A, bool), acc A, list []T) A {
+	for i := len(list) - 1; i >= 0; i-- {
+		var cont bool
+		if acc, cont = fun(list[i], acc); !cont {
+			break
+		}
+	}
+	return acc
+}
+
 // ForEach calls fun(elem) for each element in List. This function is used for its side effects and the evaluation order is defined to be the same as the order of the elements in the list.
 func ForEach[T any](fun func(T), list []T) {
 	for _, v := range list {
@@ -163,8 +185,8 @@ func Map[T any, U any](fun func(T) U, list []T) []U {
 	return newList
 }
 
-// MapFoldL calls fun(elem) on successive elements of list, starting with acc. fun must return the value for the new list and a new accumulator, which is passed to the next call. The function returns the new list and the final value of the accumulator.
-func MapFoldL[T any, U any](fun func(T, T) (U, T), acc T, list []T) ([]U, T) {
+// MapFoldL calls fun(elem, acc) on successive elements of list, starting with acc. fun must return the value for the new list and a new accumulator, which is passed to the next call. The function returns the new list and the final value of the accumulator. The accumulator may be of a different type than the elements.
+func MapFoldL[T any, U any, A any](fun func(T, A) (U, A), acc A, list []T) ([]U, A) {
 	var newList []U
 	for _, v := range list {
 		var u U
@@ -175,7 +197,7 @@ func MapFoldL[T any, U any](fun func(T, T) (U, T), acc T, list []T) ([]U, T) {
 }
 
 // MapFoldR is like MapFoldL, but the list is traversed from right to left.
-func MapFoldR[T any, U any](fun func(T, T) (U, T), acc T, list []T) ([]U, T) {
+func MapFoldR[T any, U any, A any](fun func(T, A) (U, A), acc A, list []T) ([]U, A) {
 	var newList []U
 	for i := len(list) - 1; i >= 0; i-- {
 		var u U
@@ -185,6 +207,21 @@ func MapFoldR[T any, U any](fun func(T, T) (U, T), acc T, list []T) ([]U, T) {
 	return newList, acc
 }
 
+// MapFoldWhile is like MapFoldL, but fun must also return a boolean. The traversal continues while fun returns true and halts as soon as it returns false. The value returned by the halting call is still part of the new list.
+func MapFoldWhile[T any, U any, A any](fun func(T, A) (U, A, bool), acc A, list []T) ([]U, A) {
+	var newList []U
+	for _, v := range list {
+		var u U
+		var cont bool
+		u, acc, cont = fun(v, acc)
+		newList = append(newList, u)
+		if !cont {
+			break
+		}
+	}
+	return newList, acc
+}
+
 // Max returns the first element of List that compares greater than or equal to all other elements of List.
 func Max[T constraints.Ordered](list []T) (T, bool) {
 	if len(list) == 0 {
@@ -211,10 +248,18 @@ func Member[T comparable](t T, list []T) bool {
 }
 
 // Merge returns the sorted list formed by merging all the sublists. All sublists must be sorted before evaluating this function. When two elements compare equal, the element from the sublist with the lowest position is picked before the other element.
-func Merge[T comparable](lists ...[]T) []T {
-	var newList []T
+func Merge[T constraints.Ordered](lists ...[]T) []T {
+	return merge(compare[T], false, lists)
+}
 
-	return newList
+// Merge3 returns the sorted list formed by merging list1, list2 and list3. All of list1, list2 and list3 must be sorted before evaluating this function. When two elements compare equal, the element from list1, if there is such an element, is picked before the other element, otherwise the element from list2 is picked before the element from list3.
+func Merge3[T constraints.Ordered](list1, list2, list3 []T) []T {
+	return merge(compare[T], false, [][]T{list1, list2, list3})
+}
+
+// MergeFunc is like Merge, but the elements are ordered by cmp. cmp must return a negative number if a is less than b, zero if they compare equal and a positive number if a is greater than b. All sublists must be sorted according to cmp before evaluating this function.
+func MergeFunc[T any](cmp func(a, b T) int, lists ...[]T) []T {
+	return merge(cmp, false, lists)
 }
 
 // Min returns the first element of List that compares less than or equal to all other elements of List.
@@ -263,6 +308,36 @@ func Partition[T any](pred func(T) bool, list []T) ([]T, []T) {
 	return left, right
 }
 
+// PartitionMap calls fun(elem) on successive elements of list and partitions the results into two lists of possibly different types. fun must return a boolean, a left value and a right value; the left value is added to the first list if the boolean is true, otherwise the right value is added to the second list.
+func PartitionMap[T any, L any, R any](fun func(T) (bool, L, R), list []T) ([]L, []R) {
+	var left []L
+	var right []R
+	for _, v := range list {
+		if ok, l, r := fun(v); ok {
+			left = append(left, l)
+		} else {
+			right = append(right, r)
+		}
+	}
+	return left, right
+}
+
+// PartitionN partitions list into n lists, where list i contains all elements for which classify(elem) returns i. Returns false if classify returns a number outside the range 0 to n-1.
+func PartitionN[T any](classify func(T) int, n int, list []T) ([][]T, bool) {
+	if n < 0 {
+		return nil, false
+	}
+	newList := make([][]T, n)
+	for _, v := range list {
+		i := classify(v)
+		if i < 0 || i >= n {
+			return nil, false
+		}
+		newList[i] = append(newList[i], v)
+	}
+	return newList, true
+}
+
 // Prefix returns true if list1 is a prefix of list2, otherwise false.
 func Prefix[T comparable](list1 []T, list2 []T) bool {
 	if len(list1) > len(list2) {
@@ -276,6 +351,25 @@ func Prefix[T comparable](list1 []T, list2 []T) bool {
 	return true
 }
 
+// Reduce is FoldL for the common case where the accumulator has the same type as the elements.
+func Reduce[T any](fun func(T, T) T, acc T, list []T) T {
+	return FoldL(fun, acc, list)
+}
+
+// Reduce1 is like Reduce, but the first element of list is used as the initial accumulator. Returns false if list is empty.
+func Reduce1[T any](fun func(T, T) T, list []T) (T, bool) {
+	if len(list) == 0 {
+		var empty T
+		return empty, false
+	}
+	return FoldL(fun, list[0], list[1:]), true
+}
+
+// ReduceWhile is FoldLWhile for the common case where the accumulator has the same type as the elements.
+func ReduceWhile[T any](fun func(T, T) (T, bool), acc T, list []T) T {
+	return FoldLWhile(fun, acc, list)
+}
+
 // Reverse returns a list with the elements in list in reverse order.
 func Reverse[T any](list []T) []T {
 	var newList []T
@@ -285,6 +379,54 @@ func Reverse[T any](list []T) []T {
 	return newList
 }
 
+// Scan1 is like ScanL, but the first element of list is used as the initial accumulator and is the first element of the result.
+func Scan1[T any](fun func(T, T) T, list []T) []T {
+	if len(list) == 0 {
+		return []T{}
+	}
+	return append([]T{list[0]}, ScanL(fun, list[0], list[1:])...)
+}
+
+// ScanL is like FoldL, but returns the list of all successive accumulators instead of only the final one. Element i of the result is the accumulator after fun has been called on the element at position i, so the last element is the value FoldL would return.
+func ScanL[T any, A any](fun func(T, A) A, acc A, list []T) []A {
+	newList := make([]A, len(list))
+	for i, v := range list {
+		acc = fun(v, acc)
+		newList[i] = acc
+	}
+	return newList
+}
+
+// ScanLExclusive is like ScanL, but element i of the result is the accumulator before fun has been called on the element at position i, so the first element is acc and the final accumulator is not included.
+func ScanLExclusive[T any, A any](fun func(T, A) A, acc A, list []T) []A {
+	newList := make([]A, len(list))
+	for i, v := range list {
+		newList[i] = acc
+		acc = fun(v, acc)
+	}
+	return newList
+}
+
+// ScanR is like ScanL, but the list is traversed from right to left. The result keeps the positions of list: element i is the accumulator after fun has been called on the element at position i, so the first element is the value FoldR would return.
+func ScanR[T any, A any](fun func(T, A) A, acc A, list []T) []A {
+	newList := make([]A, len(list))
+	for i := len(list) - 1; i >= 0; i-- {
+		acc = fun(list[i], acc)
+		newList[i] = acc
+	}
+	return newList
+}
+
+// ScanRExclusive is like ScanR, but element i of the result is the accumulator before fun has been called on the element at position i, so the last element is acc.
+func ScanRExclusive[T any, A any](fun func(T, A) A, acc A, list []T) []A {
+	newList := make([]A, len(list))
+	for i := len(list) - 1; i >= 0; i-- {
+		newList[i] = acc
+		acc = fun(list[i], acc)
+	}
+	return newList
+}
+
 // Search returns the first value in list such that pred(value) returns true. The pred function must return a boolean.
 func Search[T any](pred func(T) bool, list []T) (T, bool) {
 	for _, v := range list {
@@ -296,13 +438,25 @@ func Search[T any](pred func(T) bool, list []T) (T, bool) {
 	return empty, false
 }
 
-// Seq returns a sequence of integers that starts with from and contains the successive results of adding incr to the previous element, until to is reached or passed (in the latter case, to is not an element of the sequence).
-func Seq(from, to, incr int) []int {
-	var newList []int
-	for i := from; i <= to; i += incr {
-		newList = append(newList, i)
+// Seq returns a sequence of integers that starts with from and contains the successive results of adding incr to the previous element, until to is reached or passed (in the latter case, to is not an element of the sequence). incr may be negative for a decreasing sequence, which rules out decreasing sequences of an unsigned T: such a sequence is the Reverse of an increasing one, or can be built with a signed type. As in Erlang, an error is returned if to is more than one increment short of from in the direction of incr, or if incr is 0 and from and to differ. An error is also returned if the sequence has more than 2^28 elements; NewRange describes such sequences without allocating them.
+func Seq[T constraints.Integer](from, to, incr T) ([]T, error) {
+	r, err := NewRange(from, to, incr)
+	if err != nil {
+		return nil, err
 	}
-	return newList
+	if err = checkSeqLen(float64(r.Len()), from, to, incr); err != nil {
+		return nil, err
+	}
+	newList := make([]T, r.Len())
+	for i := range newList {
+		newList[i], _ = r.At(i)
+	}
+	return newList, nil
+}
+
+// Seq1 is Seq with an increment of 1.
+func Seq1[T constraints.Integer](from, to T) ([]T, error) {
+	return Seq(from, to, 1)
 }
 
 // Split pplits list into two lists. First list contains the first N elements and the second list the remaining elements
@@ -349,15 +503,13 @@ func Suffix[T comparable](list1 []T, list2 []T) bool {
 }
 
 // Sum returns the sum of the elements in List.
-/*
-func Sum[T numeric](list []T) T {
+func Sum[T Number](list []T) T {
 	var sum T
 	for _, v := range list {
 		sum += v
 	}
 	return sum
 }
-*/
 
 // TakeWhile takes elements from list while pred(Elem) returns true, that is, the function returns the longest prefix of the list for which all elements satisfy the predicate. The pred function must return a boolean.
 func TakeWhile(pred func(x int) bool, list []int) []int {
@@ -370,3 +522,13 @@ func TakeWhile(pred func(x int) bool, list []int) []int {
 	}
 	return result
 }
+
+// UMerge returns the sorted list formed by merging all the sublists. All sublists must be sorted and contain no duplicates before evaluating this function. When two elements compare equal, the element from the sublist with the lowest position is picked and the other is deleted.
+func UMerge[T constraints.Ordered](lists ...[]T) []T {
+	return merge(compare[T], true, lists)
+}
+
+// UMergeFunc is like UMerge, but the elements are ordered by cmp, as in MergeFunc.
+func UMergeFunc[T any](cmp func(a, b T) int, lists ...[]T) []T {
+	return merge(cmp, true, lists)
+}
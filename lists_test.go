@@ -1,10 +1,25 @@
 package lists
 
 import (
+	"errors"
+	"math"
 	"strconv"
 	"testing"
 )
 
+// equal reports whether list1 and list2 hold the same elements in the same order.
+func equal[T comparable](list1, list2 []T) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if list1[i] != list2[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func TestAll(t *testing.T) {
 	if !All(func(x int) bool { return x > 0 }, []int{1, 2, 3}) {
 		t.Error("All(func(x int) bool { return x > 0 }, []int{1, 2, 3}) != true")
@@ -114,6 +129,27 @@ func TestFoldL(t *testing.T) {
 	if result != 6 {
 		t.Error("Fold(func(x, y int) int { return x + y }, 0, []int{1, 2, 3}) != 6")
 	}
+	counts := FoldL(func(x string, acc map[string]int) map[string]int {
+		acc[x]++
+		return acc
+	}, map[string]int{}, []string{"a", "b", "a"})
+	if len(counts) != 2 || counts["a"] != 2 || counts["b"] != 1 {
+		t.Error(`FoldL(count, map[string]int{}, []string{"a", "b", "a"}) != map[string]int{"a": 2, "b": 1}`)
+	}
+}
+
+func TestFoldLWhile(t *testing.T) {
+	calls := 0
+	result := FoldLWhile(func(x, acc int) (int, bool) {
+		calls++
+		return acc + x, acc+x < 5
+	}, 0, []int{1, 2, 3, 4, 5})
+	if result != 6 || calls != 3 {
+		t.Error("FoldLWhile(sumBelow5, 0, []int{1, 2, 3, 4, 5}) != 6 after 3 calls")
+	}
+	if FoldLWhile(func(x, acc int) (int, bool) { return acc + x, true }, 0, []int{1, 2, 3}) != 6 {
+		t.Error("FoldLWhile(sum, 0, []int{1, 2, 3}) != 6")
+	}
 }
 
 func TestFoldR(t *testing.T) {
@@ -121,6 +157,19 @@ func TestFoldR(t *testing.T) {
 	if result != 6 {
 		t.Error("Fold(func(x, y int) int { return x + y }, 0, []int{1, 2, 3}) != 6")
 	}
+	digits := FoldR(func(x int, acc string) string { return acc + strconv.Itoa(x) }, "", []int{1, 2, 3})
+	if digits != "321" {
+		t.Error(`FoldR(func(x int, acc string) string { return acc + strconv.Itoa(x) }, "", []int{1, 2, 3}) != "321"`)
+	}
+}
+
+func TestFoldRWhile(t *testing.T) {
+	result := FoldRWhile(func(x int, acc []int) ([]int, bool) {
+		return append(acc, x), x > 3
+	}, nil, []int{1, 2, 3, 4, 5})
+	if !equal(result, []int{5, 4, 3}) {
+		t.Error("FoldRWhile(collectAbove3, nil, []int{1, 2, 3, 4, 5}) != []int{5, 4, 3}")
+	}
 }
 
 func TestForEach(t *testing.T) {
@@ -172,6 +221,13 @@ func TestMapFoldL(t *testing.T) {
 	if result != 6 {
 		t.Error("MapFoldL(fun, 0, []int{1, 2, 3}) != 6")
 	}
+	type stats struct{ count, total int }
+	lengths, acc := MapFoldL(func(s string, acc stats) (int, stats) {
+		return len(s), stats{acc.count + 1, acc.total + len(s)}
+	}, stats{}, []string{"a", "bb", "ccc"})
+	if !equal(lengths, []int{1, 2, 3}) || acc != (stats{3, 6}) {
+		t.Error(`MapFoldL(fun, stats{}, []string{"a", "bb", "ccc"}) != []int{1, 2, 3}, stats{3, 6}`)
+	}
 }
 
 func TestMapFoldR(t *testing.T) {
@@ -184,6 +240,15 @@ func TestMapFoldR(t *testing.T) {
 	}
 }
 
+func TestMapFoldWhile(t *testing.T) {
+	list, budget := MapFoldWhile(func(cost, budget int) (string, int, bool) {
+		return "c-" + strconv.Itoa(cost), budget - cost, budget-cost > 0
+	}, 10, []int{3, 4, 5, 6})
+	if !equal(list, []string{"c-3", "c-4", "c-5"}) || budget != -2 {
+		t.Error(`MapFoldWhile(spend, 10, []int{3, 4, 5, 6}) != []string{"c-3", "c-4", "c-5"}, -2`)
+	}
+}
+
 func TestMax(t *testing.T) {
 	m1, _ := Max([]int{1, 2, 3})
 	if m1 != 3 {
@@ -213,7 +278,59 @@ func TestMember(t *testing.T) {
 }
 
 func TestMerge(t *testing.T) {
-	Merge([]int{1, 2, 3}, []int{4, 5, 6})
+	if !equal(Merge([]int{1, 4, 7}, []int{2, 5, 8}, []int{3, 6, 9}), []int{1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Error("Merge([]int{1, 4, 7}, []int{2, 5, 8}, []int{3, 6, 9}) != []int{1, 2, 3, 4, 5, 6, 7, 8, 9}")
+	}
+	if !equal(Merge([]int{1, 2, 3}, []int{}, []int{2, 3}), []int{1, 2, 2, 3, 3}) {
+		t.Error("Merge([]int{1, 2, 3}, []int{}, []int{2, 3}) != []int{1, 2, 2, 3, 3}")
+	}
+	if m := Merge[int](); len(m) != 0 {
+		t.Error("Merge() != []int{}")
+	}
+	var lists [][]int
+	for i := 0; i < 100; i++ {
+		list, _ := Seq(i, 1000, 100)
+		lists = append(lists, list)
+	}
+	if want, _ := Seq1(0, 1000); !equal(Merge(lists...), want) {
+		t.Error("Merge(lists...) of 100 sublists != Seq1(0, 1000)")
+	}
+}
+
+func TestMergeNaN(t *testing.T) {
+	nan := math.NaN()
+	m := Merge([]float64{1, 3}, []float64{nan, 2})
+	if len(m) != 4 || !math.IsNaN(m[0]) || !equal(m[1:], []float64{1, 2, 3}) {
+		t.Errorf("Merge([]float64{1, 3}, []float64{NaN, 2}) = %v, want [NaN 1 2 3]", m)
+	}
+}
+
+func TestMerge3(t *testing.T) {
+	if !equal(Merge3([]int{1, 4}, []int{2, 5}, []int{3, 6}), []int{1, 2, 3, 4, 5, 6}) {
+		t.Error("Merge3([]int{1, 4}, []int{2, 5}, []int{3, 6}) != []int{1, 2, 3, 4, 5, 6}")
+	}
+}
+
+func TestMergeFunc(t *testing.T) {
+	type item struct{ key, list int }
+	byKey := func(a, b item) int { return a.key - b.key }
+	for _, k := range []int{2, 20} {
+		lists := make([][]item, k)
+		for i := range lists {
+			lists[i] = []item{{1, i}, {2, i}}
+		}
+		result := MergeFunc(byKey, lists...)
+		for i, v := range result {
+			if v.key != i/k+1 || v.list != i%k {
+				t.Errorf("MergeFunc(byKey, %d lists) is not stable: %v", k, result)
+				break
+			}
+		}
+	}
+	desc := func(a, b int) int { return b - a }
+	if !equal(MergeFunc(desc, []int{5, 3, 1}, []int{6, 4, 2}), []int{6, 5, 4, 3, 2, 1}) {
+		t.Error("MergeFunc(desc, []int{5, 3, 1}, []int{6, 4, 2}) != []int{6, 5, 4, 3, 2, 1}")
+	}
 }
 
 func TestMin(t *testing.T) {
@@ -272,6 +389,27 @@ func TestPartition(t *testing.T) {
 	}
 }
 
+func TestPartitionMap(t *testing.T) {
+	numbers, errs := PartitionMap(func(s string) (bool, int, error) {
+		n, err := strconv.Atoi(s)
+		return err == nil, n, err
+	}, []string{"1", "x", "2", "y"})
+	if !equal(numbers, []int{1, 2}) || len(errs) != 2 {
+		t.Error(`PartitionMap(atoi, []string{"1", "x", "2", "y"}) != []int{1, 2}, []error{...}`)
+	}
+}
+
+func TestPartitionN(t *testing.T) {
+	p, ok := PartitionN(func(x int) int { return x % 3 }, 3, []int{1, 2, 3, 4, 5, 6, 7})
+	if !ok || len(p) != 3 || !equal(p[0], []int{3, 6}) || !equal(p[1], []int{1, 4, 7}) || !equal(p[2], []int{2, 5}) {
+		t.Error("PartitionN(mod3, 3, []int{1, 2, 3, 4, 5, 6, 7}) != [][]int{{3, 6}, {1, 4, 7}, {2, 5}}")
+	}
+	_, ok = PartitionN(func(x int) int { return x }, 2, []int{0, 1, 2})
+	if ok {
+		t.Error("PartitionN(identity, 2, []int{0, 1, 2}) != false")
+	}
+}
+
 func TestPrefix(t *testing.T) {
 	if !Prefix([]int{1, 2, 3}, []int{1, 2, 3, 4, 5}) {
 		t.Error(`Prefix([]int{1, 2, 3}, []int{1, 2, 3, 4, 5}) != true`)
@@ -281,6 +419,35 @@ func TestPrefix(t *testing.T) {
 	}
 }
 
+func TestReduce(t *testing.T) {
+	if Reduce(func(x, y int) int { return x * y }, 1, []int{2, 3, 4}) != 24 {
+		t.Error("Reduce(func(x, y int) int { return x * y }, 1, []int{2, 3, 4}) != 24")
+	}
+}
+
+func TestReduce1(t *testing.T) {
+	r, ok := Reduce1(func(x, y int) int { return x - y }, []int{1, 2, 10})
+	if !ok || r != 9 {
+		t.Error("Reduce1(func(x, y int) int { return x - y }, []int{1, 2, 10}) != 9")
+	}
+	_, ok = Reduce1(func(x, y int) int { return x - y }, []int{})
+	if ok {
+		t.Error("Reduce1(func(x, y int) int { return x - y }, []int{}) != false")
+	}
+}
+
+func TestReduceWhile(t *testing.T) {
+	result := ReduceWhile(func(x, acc int) (int, bool) {
+		if x < 0 {
+			return acc, false
+		}
+		return acc + x, true
+	}, 0, []int{1, 2, -1, 4})
+	if result != 3 {
+		t.Error("ReduceWhile(sumUntilNegative, 0, []int{1, 2, -1, 4}) != 3")
+	}
+}
+
 func TestReverse(t *testing.T) {
 	r1 := Reverse([]int{1, 2, 3})
 	if r1[0] != 3 || r1[1] != 2 || r1[2] != 1 {
@@ -288,6 +455,53 @@ func TestReverse(t *testing.T) {
 	}
 }
 
+func TestScan1(t *testing.T) {
+	result := Scan1(func(x, acc int) int {
+		if x > acc {
+			return x
+		}
+		return acc
+	}, []int{3, 1, 4, 1, 5})
+	if !equal(result, []int{3, 3, 4, 4, 5}) {
+		t.Error("Scan1(max, []int{3, 1, 4, 1, 5}) != []int{3, 3, 4, 4, 5}")
+	}
+	if len(Scan1(func(x, acc int) int { return x + acc }, []int{})) != 0 {
+		t.Error("Scan1(sum, []int{}) != []int{}")
+	}
+}
+
+func TestScanL(t *testing.T) {
+	result := ScanL(func(x, acc int) int { return x + acc }, 0, []int{1, 2, 3})
+	if !equal(result, []int{1, 3, 6}) {
+		t.Error("ScanL(sum, 0, []int{1, 2, 3}) != []int{1, 3, 6}")
+	}
+	lengths := ScanL(func(s string, acc int) int { return len(s) + acc }, 0, []string{"a", "bb", "ccc"})
+	if !equal(lengths, []int{1, 3, 6}) {
+		t.Error(`ScanL(sumLen, 0, []string{"a", "bb", "ccc"}) != []int{1, 3, 6}`)
+	}
+}
+
+func TestScanLExclusive(t *testing.T) {
+	result := ScanLExclusive(func(x, acc int) int { return x + acc }, 0, []int{1, 2, 3})
+	if !equal(result, []int{0, 1, 3}) {
+		t.Error("ScanLExclusive(sum, 0, []int{1, 2, 3}) != []int{0, 1, 3}")
+	}
+}
+
+func TestScanR(t *testing.T) {
+	result := ScanR(func(x int, acc string) string { return acc + strconv.Itoa(x) }, "", []int{1, 2, 3})
+	if !equal(result, []string{"321", "32", "3"}) {
+		t.Error(`ScanR(concat, "", []int{1, 2, 3}) != []string{"321", "32", "3"}`)
+	}
+}
+
+func TestScanRExclusive(t *testing.T) {
+	result := ScanRExclusive(func(x, acc int) int { return x + acc }, 0, []int{1, 2, 3})
+	if !equal(result, []int{5, 3, 0}) {
+		t.Error("ScanRExclusive(sum, 0, []int{1, 2, 3}) != []int{5, 3, 0}")
+	}
+}
+
 func TestSearch(t *testing.T) {
 	s1, _ := Search(func(x int) bool { return x > 2 }, []int{1, 2, 3, 4, 5})
 	if s1 != 3 {
@@ -300,10 +514,61 @@ func TestSearch(t *testing.T) {
 }
 
 func TestSeq(t *testing.T) {
-	s1 := Seq(1, 10, 3)
-	if s1[0] != 1 || s1[1] != 4 || s1[2] != 7 || s1[3] != 10 {
+	s1, err := Seq(1, 10, 3)
+	if err != nil || s1[0] != 1 || s1[1] != 4 || s1[2] != 7 || s1[3] != 10 {
 		t.Error(`Seq(1, 10, 3) != []int{1, 4, 7, 10}`)
 	}
+	s2, err := Seq(10, 1, -4)
+	if err != nil || !equal(s2, []int{10, 6, 2}) {
+		t.Error(`Seq(10, 1, -4) != []int{10, 6, 2}`)
+	}
+	s3, err := Seq(1, 0, 1)
+	if err != nil || len(s3) != 0 {
+		t.Error(`Seq(1, 0, 1) != []int{}`)
+	}
+	s4, err := Seq(5, 5, 0)
+	if err != nil || !equal(s4, []int{5}) {
+		t.Error(`Seq(5, 5, 0) != []int{5}`)
+	}
+	s5, err := Seq[int8](-128, 127, 85)
+	if err != nil || !equal(s5, []int8{-128, -43, 42, 127}) {
+		t.Error(`Seq[int8](-128, 127, 85) != []int8{-128, -43, 42, 127}`)
+	}
+	s6, err := Seq[uint8](250, 255, 2)
+	if err != nil || !equal(s6, []uint8{250, 252, 254}) {
+		t.Error(`Seq[uint8](250, 255, 2) != []uint8{250, 252, 254}`)
+	}
+	for _, args := range [][3]int{{1, -1, 1}, {1, 3, -1}, {1, 2, 0}} {
+		if _, err = Seq(args[0], args[1], args[2]); !errors.Is(err, ErrBadArgument) {
+			t.Errorf("Seq(%d, %d, %d) did not return ErrBadArgument", args[0], args[1], args[2])
+		}
+	}
+	if _, err = Seq[uint](10, 1, 1); !errors.Is(err, ErrBadArgument) {
+		t.Error("Seq[uint](10, 1, 1) did not return ErrBadArgument, but unsigned sequences cannot decrease")
+	}
+	s7, err := Seq[uint](1, 10, 3)
+	if err != nil || !equal(Reverse(s7), []uint{10, 7, 4, 1}) {
+		t.Error("Reverse(Seq[uint](1, 10, 3)) != []uint{10, 7, 4, 1}")
+	}
+	if _, err = Seq(0, math.MaxInt, 1); !errors.Is(err, ErrBadArgument) {
+		t.Error("Seq(0, math.MaxInt, 1) did not return ErrBadArgument")
+	}
+	if _, err = Seq[int64](math.MinInt64, math.MaxInt64, 1); !errors.Is(err, ErrBadArgument) {
+		t.Error("Seq[int64](math.MinInt64, math.MaxInt64, 1) did not return ErrBadArgument")
+	}
+	if _, err = Seq(0, 1<<28, 1); !errors.Is(err, ErrBadArgument) {
+		t.Error("Seq(0, 1<<28, 1) did not return ErrBadArgument")
+	}
+}
+
+func TestSeq1(t *testing.T) {
+	s, err := Seq1(3, 5)
+	if err != nil || !equal(s, []int{3, 4, 5}) {
+		t.Error(`Seq1(3, 5) != []int{3, 4, 5}`)
+	}
+	if _, err = Seq1(3, 1); !errors.Is(err, ErrBadArgument) {
+		t.Error(`Seq1(3, 1) did not return ErrBadArgument`)
+	}
 }
 
 func TestSplit(t *testing.T) {
@@ -340,7 +605,6 @@ func TestSuffix(t *testing.T) {
 	}
 }
 
-/*
 func TestSum(t *testing.T) {
 	if Sum([]int{1, 2, 3}) != 6 {
 		t.Error(`Sum([]int{1, 2, 3}) != 6`)
@@ -348,8 +612,10 @@ func TestSum(t *testing.T) {
 	if Sum([]float64{1.0, 2.0, 3.0}) != 6.0 {
 		t.Error(`Sum([]float64{1.0, 2.0, 3.0}) != 6.0`)
 	}
+	if Sum([]int{}) != 0 {
+		t.Error(`Sum([]int{}) != 0`)
+	}
 }
-*/
 
 func TestTakeWhile(t *testing.T) {
 	s1 := TakeWhile(func(x int) bool { return x < 3 }, []int{1, 2, 3, 4, 5})
@@ -357,3 +623,17 @@ func TestTakeWhile(t *testing.T) {
 		t.Error(`TakeWhile(func(x int) bool { return x < 3 }, []int{1, 2, 3, 4, 5}) != []int{1, 2}`)
 	}
 }
+
+func TestUMerge(t *testing.T) {
+	if !equal(UMerge([]int{1, 2, 4}, []int{2, 3, 4}, []int{4, 5}), []int{1, 2, 3, 4, 5}) {
+		t.Error("UMerge([]int{1, 2, 4}, []int{2, 3, 4}, []int{4, 5}) != []int{1, 2, 3, 4, 5}")
+	}
+}
+
+func TestUMergeFunc(t *testing.T) {
+	type item struct{ key, list int }
+	result := UMergeFunc(func(a, b item) int { return a.key - b.key }, []item{{1, 0}, {2, 0}}, []item{{1, 1}, {3, 1}})
+	if len(result) != 3 || result[0] != (item{1, 0}) || result[1] != (item{2, 0}) || result[2] != (item{3, 1}) {
+		t.Error("UMergeFunc(byKey, []item{{1, 0}, {2, 0}}, []item{{1, 1}, {3, 1}}) != []item{{1, 0}, {2, 0}, {3, 1}}")
+	}
+}
@@ -0,0 +1,115 @@
+package lists
+
+import (
+	"constraints"
+)
+
+// mergeHeapThreshold is the number of non-empty sublists from which merge keeps the heads in a heap instead of scanning them linearly.
+const mergeHeapThreshold = 8
+
+// compare is the natural order of an ordered type, in the form expected by the Func variants. As in cmp.Compare, a floating-point NaN compares equal to another NaN and less than any other value, so that the order is total.
+func compare[T constraints.Ordered](a, b T) int {
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return -1
+	case bNaN:
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// cursor is the position of the next element to be merged from lists[list].
+type cursor struct {
+	list, pos int
+}
+
+// merge performs a stable k-way merge of lists ordered by cmp. Ties are resolved in favour of the sublist with the lowest position. If unique is true, elements comparing equal to the previously merged element are dropped.
+func merge[T any](cmp func(a, b T) int, unique bool, lists [][]T) []T {
+	n := 0
+	var heads []cursor
+	for i, list := range lists {
+		if len(list) > 0 {
+			n += len(list)
+			heads = append(heads, cursor{i, 0})
+		}
+	}
+	newList := make([]T, 0, n)
+	emit := func(v T) {
+		if unique && len(newList) > 0 && cmp(newList[len(newList)-1], v) == 0 {
+			return
+		}
+		newList = append(newList, v)
+	}
+	// less orders heads by value, then by sublist position, which keeps the merge stable.
+	less := func(a, b cursor) bool {
+		c := cmp(lists[a.list][a.pos], lists[b.list][b.pos])
+		return c < 0 || c == 0 && a.list < b.list
+	}
+
+	if len(heads) < mergeHeapThreshold {
+		for len(heads) > 0 {
+			min := 0
+			for i := 1; i < len(heads); i++ {
+				if less(heads[i], heads[min]) {
+					min = i
+				}
+			}
+			c := &heads[min]
+			emit(lists[c.list][c.pos])
+			c.pos++
+			if c.pos == len(lists[c.list]) {
+				heads = append(heads[:min], heads[min+1:]...)
+			}
+		}
+		return newList
+	}
+
+	h := cursorHeap{heads, less}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	for len(h.items) > 0 {
+		c := &h.items[0]
+		emit(lists[c.list][c.pos])
+		c.pos++
+		if c.pos == len(lists[c.list]) {
+			last := len(h.items) - 1
+			h.items[0] = h.items[last]
+			h.items = h.items[:last]
+		}
+		h.down(0)
+	}
+	return newList
+}
+
+// cursorHeap is a binary min-heap of cursors ordered by less.
+type cursorHeap struct {
+	items []cursor
+	less  func(a, b cursor) bool
+}
+
+// down restores the heap property below index i.
+func (h *cursorHeap) down(i int) {
+	n := len(h.items)
+	for {
+		min := i
+		if l := 2*i + 1; l < n && h.less(h.items[l], h.items[min]) {
+			min = l
+		}
+		if r := 2*i + 2; r < n && h.less(h.items[r], h.items[min]) {
+			min = r
+		}
+		if min == i {
+			return
+		}
+		h.items[i], h.items[min] = h.items[min], h.items[i]
+		i = min
+	}
+}
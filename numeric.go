@@ -0,0 +1,91 @@
+package lists
+
+import (
+	"constraints"
+)
+
+// Number is the constraint satisfied by all integer and floating-point types.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Average returns the arithmetic mean of the elements in list. Returns false if list is empty.
+func Average[T Number](list []T) (float64, bool) {
+	return AverageBy(identity[T], list)
+}
+
+// AverageBy returns the arithmetic mean of fun(elem) for all elements in list. Returns false if list is empty. The sum is compensated, as in SumFloat.
+func AverageBy[T any, N Number](fun func(T) N, list []T) (float64, bool) {
+	if len(list) == 0 {
+		return 0, false
+	}
+	var sum, c float64
+	for _, v := range list {
+		sum, c = neumaier(sum, c, float64(fun(v)))
+	}
+	return (sum + c) / float64(len(list)), true
+}
+
+// Product returns the product of the elements in list. The product of the empty list is 1.
+func Product[T Number](list []T) T {
+	product := T(1)
+	for _, v := range list {
+		product *= v
+	}
+	return product
+}
+
+// SumBy returns the sum of fun(elem) for all elements in list.
+func SumBy[T any, N Number](fun func(T) N, list []T) N {
+	var sum N
+	for _, v := range list {
+		sum += fun(v)
+	}
+	return sum
+}
+
+// SumChecked is like Sum, but returns false instead of a wrapped-around result if the sum overflows T.
+func SumChecked[T constraints.Integer](list []T) (T, bool) {
+	var sum T
+	for _, v := range list {
+		s := sum + v
+		if v > 0 && s < sum || v < 0 && s > sum {
+			return 0, false
+		}
+		sum = s
+	}
+	return sum, true
+}
+
+// SumFloat returns the sum of the elements in list using Neumaier's compensated summation, which keeps the rounding error independent of the length of list.
+func SumFloat[T constraints.Float](list []T) T {
+	var sum, c T
+	for _, v := range list {
+		sum, c = neumaier(sum, c, v)
+	}
+	return sum + c
+}
+
+// neumaier adds x to the running sum and returns the new sum and the new compensation term c, which collects the low-order bits lost by the addition.
+func neumaier[T constraints.Float](sum, c, x T) (T, T) {
+	t := sum + x
+	if abs(sum) >= abs(x) {
+		c += (sum - t) + x
+	} else {
+		c += (x - t) + sum
+	}
+	return t, c
+}
+
+// abs returns the absolute value of x.
+func abs[T Number](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// identity returns its argument, for use as the key function of the By variants.
+func identity[T any](v T) T {
+	return v
+}
@@ -0,0 +1,77 @@
+package lists
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAverage(t *testing.T) {
+	a, ok := Average([]int{1, 2, 3, 4})
+	if !ok || a != 2.5 {
+		t.Error("Average([]int{1, 2, 3, 4}) != 2.5")
+	}
+	_, ok = Average([]float64{})
+	if ok {
+		t.Error("Average([]float64{}) != false")
+	}
+}
+
+func TestAverageBy(t *testing.T) {
+	a, ok := AverageBy(func(s string) int { return len(s) }, []string{"a", "bb", "ccc"})
+	if !ok || a != 2 {
+		t.Error(`AverageBy(len, []string{"a", "bb", "ccc"}) != 2`)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	if Product([]int{2, 3, 4}) != 24 {
+		t.Error("Product([]int{2, 3, 4}) != 24")
+	}
+	if Product([]float64{}) != 1 {
+		t.Error("Product([]float64{}) != 1")
+	}
+}
+
+func TestSumBy(t *testing.T) {
+	type order struct {
+		id    string
+		total float64
+	}
+	orders := []order{{"a", 1.5}, {"b", 2.5}}
+	if SumBy(func(o order) float64 { return o.total }, orders) != 4 {
+		t.Error("SumBy(total, orders) != 4")
+	}
+}
+
+func TestSumChecked(t *testing.T) {
+	s, ok := SumChecked([]int8{100, 27})
+	if !ok || s != 127 {
+		t.Error("SumChecked([]int8{100, 27}) != 127")
+	}
+	_, ok = SumChecked([]int8{100, 28})
+	if ok {
+		t.Error("SumChecked([]int8{100, 28}) != false")
+	}
+	_, ok = SumChecked([]int8{-100, -29})
+	if ok {
+		t.Error("SumChecked([]int8{-100, -29}) != false")
+	}
+	_, ok = SumChecked([]uint8{200, 56})
+	if ok {
+		t.Error("SumChecked([]uint8{200, 56}) != false")
+	}
+	s, ok = SumChecked([]int8{127, -1, -126})
+	if !ok || s != 0 {
+		t.Error("SumChecked([]int8{127, -1, -126}) != 0")
+	}
+}
+
+func TestSumFloat(t *testing.T) {
+	if SumFloat([]float64{1, 1e100, 1, -1e100}) != 2 {
+		t.Error("SumFloat([]float64{1, 1e100, 1, -1e100}) != 2")
+	}
+	list := Duplicate(0.1, 1000000)
+	if math.Abs(SumFloat(list)-100000) > 1e-9 {
+		t.Error("SumFloat(Duplicate(0.1, 1000000)) != 100000")
+	}
+}
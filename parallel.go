@@ -0,0 +1,148 @@
+package lists
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// PanicError is the value a Parallel function panics with when its function argument panicked in a worker goroutine. The panic is raised in the calling goroutine once all workers have stopped.
+type PanicError struct {
+	// Value is the value the worker panicked with.
+	Value any
+	// Stack is the stack trace of the worker at the time of the panic.
+	Stack []byte
+}
+
+// Error returns the panic value and the stack trace of the worker.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("lists: panic in worker: %v\n\n%s", p.Value, p.Stack)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+// ParallelFilter is like Filter, but pred is called concurrently by up to n goroutines, as in ParallelMap. The kept elements are in the order of list.
+func ParallelFilter[T any](n int, pred func(T) bool, list []T) []T {
+	keep := ParallelMap(n, pred, list)
+	newList := make([]T, 0)
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// ParallelFilterMap is like FilterMap, but fun is called concurrently by up to n goroutines, as in ParallelMap.
+func ParallelFilterMap[T any](n int, fun func(T) (bool, T), list []T) []T {
+	type result struct {
+		ok    bool
+		value T
+	}
+	results := ParallelMap(n, func(v T) result {
+		ok, value := fun(v)
+		return result{ok, value}
+	}, list)
+	newList := make([]T, 0)
+	for _, r := range results {
+		if r.ok {
+			newList = append(newList, r.value)
+		}
+	}
+	return newList
+}
+
+// ParallelFlatMap is like FlatMap, but fun is called concurrently by up to n goroutines, as in ParallelMap. The results are concatenated in the order of list.
+func ParallelFlatMap[T any, U any](n int, fun func(T) []U, list []T) []U {
+	return FlatMap(identity[[]U], ParallelMap(n, fun, list))
+}
+
+// ParallelForEach calls fun(ctx, elem) for each element in list from up to n goroutines. As soon as a call returns an error, the context passed to the other calls is cancelled, no new calls are started and the first error is returned once all running calls have returned. The same happens when ctx is done, in which case its error is returned. If n is not positive, runtime.GOMAXPROCS(0) goroutines are used. A panic in fun is raised again in the calling goroutine as a PanicError.
+func ParallelForEach[T any](ctx context.Context, n int, fun func(context.Context, T) error, list []T) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	parallel(n, len(list), func(i int) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		if err := fun(ctx, list[i]); err != nil {
+			cancel(err)
+			return false
+		}
+		return true
+	})
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	return nil
+}
+
+// ParallelMap is like Map, but fun is called concurrently by up to n goroutines, or runtime.GOMAXPROCS(0) goroutines if n is not positive. The results are in the order of list, whatever the order of the calls. If fun panics, the panic is raised again in the calling goroutine, wrapped in a PanicError, once all workers have stopped.
+func ParallelMap[T any, U any](n int, fun func(T) U, list []T) []U {
+	newList := make([]U, len(list))
+	parallel(n, len(list), func(i int) bool {
+		newList[i] = fun(list[i])
+		return true
+	})
+	return newList
+}
+
+// ParallelPartition is like Partition, but pred is called concurrently by up to n goroutines, as in ParallelMap. Both lists keep the order of list.
+func ParallelPartition[T any](n int, pred func(T) bool, list []T) ([]T, []T) {
+	keep := ParallelMap(n, pred, list)
+	var left, right []T
+	for i, v := range list {
+		if keep[i] {
+			left = append(left, v)
+		} else {
+			right = append(right, v)
+		}
+	}
+	return left, right
+}
+
+// parallel calls fun(i) for every i from 0 to count-1 from up to n goroutines, or runtime.GOMAXPROCS(0) if n is not positive, handing out positions in increasing order until fun returns false. It returns when all calls have returned, raising again the first panic of a worker.
+func parallel(n, count int, fun func(i int) bool) {
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	if n > count {
+		n = count
+	}
+	var next atomic.Int64
+	var stop atomic.Bool
+	var failure atomic.Pointer[PanicError]
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for w := 0; w < n; w++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					failure.CompareAndSwap(nil, &PanicError{r, debug.Stack()})
+					stop.Store(true)
+				}
+			}()
+			for !stop.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= count {
+					return
+				}
+				if !fun(i) {
+					stop.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if p := failure.Load(); p != nil {
+		panic(p)
+	}
+}
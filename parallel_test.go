@@ -0,0 +1,125 @@
+package lists
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// tracking returns a function wrapping fun that records in max the highest number of concurrent calls.
+func tracking[T any, U any](max *int64, fun func(T) U) func(T) U {
+	var running int64
+	return func(v T) U {
+		n := atomic.AddInt64(&running, 1)
+		for {
+			m := atomic.LoadInt64(max)
+			if n <= m || atomic.CompareAndSwapInt64(max, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		defer atomic.AddInt64(&running, -1)
+		return fun(v)
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	result := ParallelFilter(3, func(x int) bool { return x%2 != 0 }, []int{1, 2, 3, 4, 5})
+	if !equal(result, []int{1, 3, 5}) {
+		t.Error("ParallelFilter(3, isOdd, []int{1, 2, 3, 4, 5}) != []int{1, 3, 5}")
+	}
+}
+
+func TestParallelFilterMap(t *testing.T) {
+	result := ParallelFilterMap(2, func(x int) (bool, int) { return x%2 != 0, x * 2 }, []int{1, 2, 3, 4, 5})
+	if !equal(result, []int{2, 6, 10}) {
+		t.Error("ParallelFilterMap(2, doubleOdd, []int{1, 2, 3, 4, 5}) != []int{2, 6, 10}")
+	}
+}
+
+func TestParallelFlatMap(t *testing.T) {
+	result := ParallelFlatMap(2, func(x int) []int { return []int{x, x} }, []int{1, 2, 3})
+	if !equal(result, []int{1, 1, 2, 2, 3, 3}) {
+		t.Error("ParallelFlatMap(2, twice, []int{1, 2, 3}) != []int{1, 1, 2, 2, 3, 3}")
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum atomic.Int64
+	err := ParallelForEach(context.Background(), 4, func(_ context.Context, x int) error {
+		sum.Add(int64(x))
+		return nil
+	}, []int{1, 2, 3, 4, 5})
+	if err != nil || sum.Load() != 15 {
+		t.Error("ParallelForEach(ctx, 4, add, []int{1, 2, 3, 4, 5}) did not visit every element")
+	}
+
+	if ParallelForEach(context.Background(), 2, func(context.Context, int) error { return errors.New("called") }, []int{}) != nil {
+		t.Error("ParallelForEach(ctx, 2, fail, []int{}) != nil")
+	}
+
+	boom := errors.New("boom")
+	var calls atomic.Int64
+	list, _ := Seq1(1, 1000)
+	err = ParallelForEach(context.Background(), 2, func(ctx context.Context, x int) error {
+		calls.Add(1)
+		if x == 3 {
+			return boom
+		}
+		if x > 3 {
+			<-ctx.Done()
+		}
+		return nil
+	}, list)
+	if !errors.Is(err, boom) || calls.Load() > 10 {
+		t.Errorf("ParallelForEach returned %v after %d calls, want boom after a few calls", err, calls.Load())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = ParallelForEach(ctx, 2, func(context.Context, int) error { return nil }, list)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelForEach(cancelled, ...) = %v, want context.Canceled", err)
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	var max int64
+	list, _ := Seq1(1, 50)
+	result := ParallelMap(4, tracking(&max, strconv.Itoa), list)
+	if !equal(result, Map(strconv.Itoa, list)) {
+		t.Error("ParallelMap(4, strconv.Itoa, list) != Map(strconv.Itoa, list)")
+	}
+	if max > 4 || max < 2 {
+		t.Errorf("ParallelMap(4, ...) ran %d calls at once, want 2 to 4", max)
+	}
+	if len(ParallelMap(0, strconv.Itoa, []int{})) != 0 {
+		t.Error("ParallelMap(0, strconv.Itoa, []int{}) != []string{}")
+	}
+}
+
+func TestParallelMapPanic(t *testing.T) {
+	defer func() {
+		p, ok := recover().(*PanicError)
+		if !ok || p.Value != "bad element" || len(p.Stack) == 0 {
+			t.Errorf("ParallelMap did not panic with a *PanicError, got %v", p)
+		}
+	}()
+	ParallelMap(2, func(x int) int {
+		if x == 3 {
+			panic("bad element")
+		}
+		return x
+	}, []int{1, 2, 3, 4})
+	t.Error("ParallelMap did not panic")
+}
+
+func TestParallelPartition(t *testing.T) {
+	p1, p2 := ParallelPartition(3, func(x int) bool { return x%2 == 0 }, []int{1, 2, 3, 4, 5})
+	if !equal(p1, []int{2, 4}) || !equal(p2, []int{1, 3, 5}) {
+		t.Error("ParallelPartition(3, isEven, []int{1, 2, 3, 4, 5}) != []int{2, 4}, []int{1, 3, 5}")
+	}
+}
@@ -0,0 +1,160 @@
+package lists
+
+import (
+	"constraints"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+)
+
+// ErrBadArgument is returned when the arguments of a sequence function describe no valid sequence, in the way Erlang raises badarg.
+var ErrBadArgument = errors.New("lists: bad argument")
+
+// maxSeqLen is the largest number of elements the sequence functions allocate. Longer sequences are reported with ErrBadArgument before any memory is allocated, since an allocation of that size would exhaust memory rather than fail; a Range describes them without holding the elements.
+const maxSeqLen = 1 << 28
+
+// Range is a lazy arithmetic sequence of integers, as returned by Seq, that does not hold its elements in memory. The zero Range is empty.
+type Range[T constraints.Integer] struct {
+	from, incr T
+	// last is the position of the last element, which is meaningful only if nonEmpty is true. Storing it instead of the length lets a Range span every value of a 64-bit type.
+	last     uint64
+	nonEmpty bool
+}
+
+// NewRange returns the Range holding the elements Seq(from, to, incr) would return, or the error Seq would return, so that for an unsigned T the Range can only be increasing.
+func NewRange[T constraints.Integer](from, to, incr T) (Range[T], error) {
+	// Differences are taken in uint64, where they cannot overflow for any integer type.
+	var step, dist uint64
+	var reached bool
+	switch {
+	case incr > 0:
+		step, dist, reached = uint64(incr), uint64(to)-uint64(from), to >= from
+	case incr < 0:
+		step, dist, reached = -uint64(incr), uint64(from)-uint64(to), to <= from
+	default:
+		if from != to {
+			return Range[T]{}, fmt.Errorf("%w: sequence from %v to %v with increment 0", ErrBadArgument, from, to)
+		}
+		return Range[T]{from, incr, 0, true}, nil
+	}
+	if !reached {
+		// to may be one increment short of from, which gives the empty sequence.
+		if -dist > step {
+			return Range[T]{}, fmt.Errorf("%w: sequence from %v to %v with increment %v", ErrBadArgument, from, to, incr)
+		}
+		return Range[T]{}, nil
+	}
+	return Range[T]{from, incr, dist / step, true}, nil
+}
+
+// All returns an iterator over the elements of r in order.
+func (r Range[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if !r.nonEmpty {
+			return
+		}
+		v := r.from
+		for i := uint64(0); yield(v) && i < r.last; i++ {
+			v += r.incr
+		}
+	}
+}
+
+// At returns the element at position i of r, if there is such an element.
+func (r Range[T]) At(i int) (T, bool) {
+	if i < 0 || !r.nonEmpty || uint64(i) > r.last {
+		var empty T
+		return empty, false
+	}
+	// The arithmetic wraps around, but the result is exact because the element is representable in T.
+	return r.from + T(i)*r.incr, true
+}
+
+// Contains returns true if v is an element of r, otherwise false.
+func (r Range[T]) Contains(v T) bool {
+	if !r.nonEmpty {
+		return false
+	}
+	var dist, step uint64
+	switch {
+	case r.incr > 0:
+		if v < r.from {
+			return false
+		}
+		dist, step = uint64(v)-uint64(r.from), uint64(r.incr)
+	case r.incr < 0:
+		if v > r.from {
+			return false
+		}
+		dist, step = uint64(r.from)-uint64(v), -uint64(r.incr)
+	default:
+		return v == r.from
+	}
+	return dist%step == 0 && dist/step <= r.last
+}
+
+// Len returns the number of elements of r, or math.MaxInt if it does not fit in an int.
+func (r Range[T]) Len() int {
+	if !r.nonEmpty {
+		return 0
+	}
+	if r.last >= math.MaxInt {
+		return math.MaxInt
+	}
+	return int(r.last) + 1
+}
+
+// FloatRange returns a sequence of floating-point numbers that starts with from and goes up or down in steps of incr until to is reached or passed, as Seq does. Every element is computed as from plus a multiple of incr, so the rounding errors do not accumulate along the sequence, and to is included when it is within rounding error of an element. Returns an error if the arguments describe no valid sequence, as in Seq, if any of them is not finite, or if the sequence has more than 2^28 elements.
+func FloatRange[T constraints.Float](from, to, incr T) ([]T, error) {
+	if !finite(from) || !finite(to) || !finite(incr) || incr == 0 && from != to {
+		return nil, fmt.Errorf("%w: sequence from %v to %v with increment %v", ErrBadArgument, from, to, incr)
+	}
+	if incr == 0 {
+		return []T{from}, nil
+	}
+	steps := (float64(to) - float64(from)) / float64(incr)
+	if steps < -1 {
+		return nil, fmt.Errorf("%w: sequence from %v to %v with increment %v", ErrBadArgument, from, to, incr)
+	}
+	count := math.Floor(steps+1e-9) + 1
+	if err := checkSeqLen(count, from, to, incr); err != nil {
+		return nil, err
+	}
+	newList := make([]T, max(int(count), 0))
+	for i := range newList {
+		newList[i] = from + T(i)*incr
+	}
+	return newList, nil
+}
+
+// Linspace returns n evenly spaced floating-point numbers from start to stop, both included. Every element is computed from its position, so the rounding errors do not accumulate, and the last element is exactly stop. Returns an error if n is negative or more than 2^28, or start or stop is not finite.
+func Linspace[T constraints.Float](start, stop T, n int) ([]T, error) {
+	if n < 0 || n > maxSeqLen || !finite(start) || !finite(stop) {
+		return nil, fmt.Errorf("%w: %d numbers from %v to %v", ErrBadArgument, n, start, stop)
+	}
+	newList := make([]T, n)
+	for i := range newList {
+		newList[i] = start + (stop-start)*T(i)/T(n-1)
+	}
+	if n > 0 {
+		newList[0] = start
+	}
+	if n > 1 {
+		newList[n-1] = stop
+	}
+	return newList, nil
+}
+
+// checkSeqLen returns an error if a sequence from from to to with increment incr has count elements and count is more than maxSeqLen.
+func checkSeqLen[T Number](count float64, from, to, incr T) error {
+	if !(count <= maxSeqLen) {
+		return fmt.Errorf("%w: sequence from %v to %v with increment %v has more than %d elements", ErrBadArgument, from, to, incr, maxSeqLen)
+	}
+	return nil
+}
+
+// finite returns true if x is neither infinite nor NaN.
+func finite[T constraints.Float](x T) bool {
+	return !math.IsInf(float64(x), 0) && !math.IsNaN(float64(x))
+}
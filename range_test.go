@@ -0,0 +1,110 @@
+package lists
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestFloatRange(t *testing.T) {
+	r, err := FloatRange(0, 1, 0.1)
+	if err != nil || len(r) != 11 || r[10] != 1 {
+		t.Errorf("FloatRange(0, 1, 0.1) = %v", r)
+	}
+	r, err = FloatRange(0.3, 0, -0.1)
+	if err != nil || len(r) != 4 {
+		t.Errorf("FloatRange(0.3, 0, -0.1) = %v, want 4 elements", r)
+	}
+	r, err = FloatRange(1, 0.5, 1)
+	if err != nil || len(r) != 0 {
+		t.Errorf("FloatRange(1, 0.5, 1) = %v, want []", r)
+	}
+	if _, err = FloatRange(0, 1, -0.1); !errors.Is(err, ErrBadArgument) {
+		t.Error("FloatRange(0, 1, -0.1) did not return ErrBadArgument")
+	}
+	for _, args := range [][3]float64{{0, 1e10, 1e-10}, {0, 1e300, 1}, {-1e308, 1e308, 1}, {0, 1e17, 1}, {0, 1e11, 1}, {0, 1 << 28, 1}} {
+		if _, err = FloatRange(args[0], args[1], args[2]); !errors.Is(err, ErrBadArgument) {
+			t.Errorf("FloatRange(%v, %v, %v) did not return ErrBadArgument", args[0], args[1], args[2])
+		}
+	}
+	if _, err = FloatRange(0, math.Inf(1), 1); !errors.Is(err, ErrBadArgument) {
+		t.Error("FloatRange(0, +Inf, 1) did not return ErrBadArgument")
+	}
+}
+
+func TestLinspace(t *testing.T) {
+	l, err := Linspace(0.0, 1.0, 5)
+	if err != nil || !equal(l, []float64{0, 0.25, 0.5, 0.75, 1}) {
+		t.Errorf("Linspace(0.0, 1.0, 5) = %v, want [0 0.25 0.5 0.75 1]", l)
+	}
+	l, err = Linspace(0.1, 0.7, 7)
+	if err != nil || l[6] != 0.7 {
+		t.Errorf("Linspace(0.1, 0.7, 7) = %v, want last element 0.7", l)
+	}
+	l, err = Linspace(2.0, 3.0, 1)
+	if err != nil || !equal(l, []float64{2}) {
+		t.Errorf("Linspace(2.0, 3.0, 1) = %v, want [2]", l)
+	}
+	if _, err = Linspace(0.0, 1.0, -1); !errors.Is(err, ErrBadArgument) {
+		t.Error("Linspace(0.0, 1.0, -1) did not return ErrBadArgument")
+	}
+	if _, err = Linspace(0.0, 1.0, math.MaxInt); !errors.Is(err, ErrBadArgument) {
+		t.Error("Linspace(0.0, 1.0, math.MaxInt) did not return ErrBadArgument")
+	}
+}
+
+func TestNewRange(t *testing.T) {
+	r, err := NewRange(1, 10, 3)
+	if err != nil || r.Len() != 4 {
+		t.Error("NewRange(1, 10, 3).Len() != 4")
+	}
+	if _, err = NewRange(1, 10, -3); !errors.Is(err, ErrBadArgument) {
+		t.Error("NewRange(1, 10, -3) did not return ErrBadArgument")
+	}
+	var zero Range[int]
+	if zero.Len() != 0 || zero.Contains(0) {
+		t.Error("Range[int]{} is not empty")
+	}
+}
+
+func TestRangeAll(t *testing.T) {
+	r, _ := NewRange(10, 1, -4)
+	if !equal(slices.Collect(r.All()), []int{10, 6, 2}) {
+		t.Error("NewRange(10, 1, -4).All() != []int{10, 6, 2}")
+	}
+}
+
+func TestRangeAt(t *testing.T) {
+	r, _ := NewRange[int8](127, -128, -1)
+	v, ok := r.At(255)
+	if !ok || v != -128 || r.Len() != 256 {
+		t.Error("NewRange[int8](127, -128, -1).At(255) != -128")
+	}
+	if _, ok = r.At(256); ok {
+		t.Error("NewRange[int8](127, -128, -1).At(256) != false")
+	}
+	big, _ := NewRange[int64](0, math.MaxInt64, 1)
+	v64, ok := big.At(1000000)
+	if !ok || v64 != 1000000 {
+		t.Error("NewRange[int64](0, math.MaxInt64, 1).At(1000000) != 1000000")
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	r, _ := NewRange(1, 10, 3)
+	if !r.Contains(7) || r.Contains(8) || r.Contains(13) || r.Contains(-2) {
+		t.Error("NewRange(1, 10, 3).Contains is wrong")
+	}
+	d, _ := NewRange(10, 1, -4)
+	if !d.Contains(2) || d.Contains(-2) || d.Contains(14) {
+		t.Error("NewRange(10, 1, -4).Contains is wrong")
+	}
+}
+
+func TestRangeLen(t *testing.T) {
+	r, _ := NewRange[uint64](0, math.MaxUint64, 1)
+	if r.Len() != math.MaxInt {
+		t.Error("NewRange[uint64](0, math.MaxUint64, 1).Len() != math.MaxInt")
+	}
+}
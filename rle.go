@@ -0,0 +1,39 @@
+package lists
+
+// Run is Count consecutive copies of Value in a run-length encoded list.
+type Run[T any] struct {
+	Value T
+	Count int
+}
+
+// RunLengthDecode returns the list encoded by runs, that is, Count copies of each Value in turn.
+func RunLengthDecode[T any](runs []Run[T]) []T {
+	newList := Concat(Map(func(r Run[T]) []T { return Duplicate(r.Value, r.Count) }, runs)...)
+	if newList == nil {
+		return []T{}
+	}
+	return newList
+}
+
+// RunLengthEncode returns list as a list of runs, where every run holds an element and the number of times it is repeated consecutively.
+func RunLengthEncode[T comparable](list []T) []Run[T] {
+	return RunLengthEncodeFunc(func(a, b T) bool { return a == b }, list)
+}
+
+// RunLengthEncodeFunc is like RunLengthEncode, but two adjacent elements belong to the same run when eq returns true for them. Every run holds the first element of the run.
+func RunLengthEncodeFunc[T any](eq func(a, b T) bool, list []T) []Run[T] {
+	newList := make([]Run[T], 0)
+	for _, v := range list {
+		if n := len(newList); n > 0 && eq(newList[n-1].Value, v) {
+			newList[n-1].Count++
+		} else {
+			newList = append(newList, Run[T]{v, 1})
+		}
+	}
+	return newList
+}
+
+// RunLengthMap returns a list of runs where fun has been called on the value of every run of runs. The counts are kept and the runs are not expanded, so fun is called once per run. Adjacent runs mapped to equal values are not merged.
+func RunLengthMap[T any, U any](fun func(T) U, runs []Run[T]) []Run[U] {
+	return Map(func(r Run[T]) Run[U] { return Run[U]{fun(r.Value), r.Count} }, runs)
+}
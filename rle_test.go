@@ -0,0 +1,38 @@
+package lists
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunLengthDecode(t *testing.T) {
+	if !equal(RunLengthDecode([]Run[string]{{"a", 2}, {"b", 1}, {"a", 3}}), []string{"a", "a", "b", "a", "a", "a"}) {
+		t.Error(`RunLengthDecode([]Run[string]{{"a", 2}, {"b", 1}, {"a", 3}}) != []string{"a", "a", "b", "a", "a", "a"}`)
+	}
+	if d := RunLengthDecode([]Run[int]{}); d == nil || len(d) != 0 {
+		t.Error("RunLengthDecode([]Run[int]{}) != []int{}")
+	}
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	if !equal(RunLengthEncode([]string{"a", "a", "b", "a", "a", "a"}), []Run[string]{{"a", 2}, {"b", 1}, {"a", 3}}) {
+		t.Error(`RunLengthEncode([]string{"a", "a", "b", "a", "a", "a"}) != []Run[string]{{"a", 2}, {"b", 1}, {"a", 3}}`)
+	}
+	if len(RunLengthEncode([]int{})) != 0 {
+		t.Error("RunLengthEncode([]int{}) != []Run[int]{}")
+	}
+}
+
+func TestRunLengthEncodeFunc(t *testing.T) {
+	result := RunLengthEncodeFunc(strings.EqualFold, []string{"a", "A", "b", "B", "b"})
+	if !equal(result, []Run[string]{{"a", 2}, {"b", 3}}) {
+		t.Error(`RunLengthEncodeFunc(strings.EqualFold, []string{"a", "A", "b", "B", "b"}) != []Run[string]{{"a", 2}, {"b", 3}}`)
+	}
+}
+
+func TestRunLengthMap(t *testing.T) {
+	result := RunLengthMap(strings.ToUpper, []Run[string]{{"a", 2}, {"b", 1}})
+	if !equal(result, []Run[string]{{"A", 2}, {"B", 1}}) {
+		t.Error(`RunLengthMap(strings.ToUpper, []Run[string]{{"a", 2}, {"b", 1}}) != []Run[string]{{"A", 2}, {"B", 1}}`)
+	}
+}
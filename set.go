@@ -0,0 +1,95 @@
+package lists
+
+// Difference returns the elements of list1 that are not in list2, without duplicates and in the order in which they first occur. It runs in linear expected time.
+func Difference[T comparable](list1, list2 []T) []T {
+	return DifferenceBy(identity[T], list1, list2)
+}
+
+// DifferenceBy is like Difference, but two elements are considered equal when key returns the same value for both.
+func DifferenceBy[T any, K comparable](key func(T) K, list1, list2 []T) []T {
+	exclude := keySet(key, list2)
+	return UniqBy(key, Filter(func(v T) bool { return !exclude[key(v)] }, list1))
+}
+
+// Intersection returns the elements of list1 that are also in list2, without duplicates and in the order in which they first occur in list1. It runs in linear expected time.
+func Intersection[T comparable](list1, list2 []T) []T {
+	return IntersectionBy(identity[T], list1, list2)
+}
+
+// IntersectionBy is like Intersection, but two elements are considered equal when key returns the same value for both.
+func IntersectionBy[T any, K comparable](key func(T) K, list1, list2 []T) []T {
+	include := keySet(key, list2)
+	return UniqBy(key, Filter(func(v T) bool { return include[key(v)] }, list1))
+}
+
+// IsDisjoint returns true if list1 and list2 have no element in common, otherwise false. It runs in linear expected time.
+func IsDisjoint[T comparable](list1, list2 []T) bool {
+	return IsDisjointBy(identity[T], list1, list2)
+}
+
+// IsDisjointBy is like IsDisjoint, but two elements are considered equal when key returns the same value for both.
+func IsDisjointBy[T any, K comparable](key func(T) K, list1, list2 []T) bool {
+	set := keySet(key, list2)
+	return !Any(func(v T) bool { return set[key(v)] }, list1)
+}
+
+// IsSubset returns true if every element of list1 is also in list2, otherwise false. Duplicates are ignored, so list1 may hold an element more often than list2. It runs in linear expected time.
+func IsSubset[T comparable](list1, list2 []T) bool {
+	return IsSubsetBy(identity[T], list1, list2)
+}
+
+// IsSubsetBy is like IsSubset, but two elements are considered equal when key returns the same value for both.
+func IsSubsetBy[T any, K comparable](key func(T) K, list1, list2 []T) bool {
+	set := keySet(key, list2)
+	return All(func(v T) bool { return set[key(v)] }, list1)
+}
+
+// Subtract returns a copy of list1 where for each element in list2, the first occurrence of that element in list1 is deleted, as Erlang's list1 -- list2 does. Unlike the other set functions it works on multisets, so duplicates are kept. It runs in linear expected time.
+func Subtract[T comparable](list1, list2 []T) []T {
+	return SubtractBy(identity[T], list1, list2)
+}
+
+// SubtractBy is like Subtract, but two elements are considered equal when key returns the same value for both.
+func SubtractBy[T any, K comparable](key func(T) K, list1, list2 []T) []T {
+	counts := CountBy(key, list2)
+	newList := make([]T, 0, len(list1))
+	for _, v := range list1 {
+		if k := key(v); counts[k] > 0 {
+			counts[k]--
+		} else {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// SymmetricDifference returns the elements of list1 that are not in list2, followed by the elements of list2 that are not in list1, without duplicates and in the order in which they first occur. It runs in linear expected time.
+func SymmetricDifference[T comparable](list1, list2 []T) []T {
+	return SymmetricDifferenceBy(identity[T], list1, list2)
+}
+
+// SymmetricDifferenceBy is like SymmetricDifference, but two elements are considered equal when key returns the same value for both.
+func SymmetricDifferenceBy[T any, K comparable](key func(T) K, list1, list2 []T) []T {
+	left, right := DifferenceBy(key, list1, list2), DifferenceBy(key, list2, list1)
+	newList := make([]T, 0, len(left)+len(right))
+	return append(append(newList, left...), right...)
+}
+
+// Union returns the elements of list1 followed by the elements of list2 that are not in list1, without duplicates and in the order in which they first occur. It runs in linear expected time.
+func Union[T comparable](list1, list2 []T) []T {
+	return UnionBy(identity[T], list1, list2)
+}
+
+// UnionBy is like Union, but two elements are considered equal when key returns the same value for both.
+func UnionBy[T any, K comparable](key func(T) K, list1, list2 []T) []T {
+	return UniqBy(key, Concat(list1, list2))
+}
+
+// keySet returns the set of key(elem) for all elements in list.
+func keySet[T any, K comparable](key func(T) K, list []T) map[K]bool {
+	set := make(map[K]bool, len(list))
+	for _, v := range list {
+		set[key(v)] = true
+	}
+	return set
+}
@@ -0,0 +1,106 @@
+package lists
+
+import (
+	"testing"
+)
+
+func TestDifference(t *testing.T) {
+	if !equal(Difference([]int{3, 1, 2, 3, 4}, []int{2, 4}), []int{3, 1}) {
+		t.Error("Difference([]int{3, 1, 2, 3, 4}, []int{2, 4}) != []int{3, 1}")
+	}
+}
+
+func TestDifferenceBy(t *testing.T) {
+	result := DifferenceBy(userID, users, []user{{1, "x"}})
+	if !equal(result, []user{{2, "bob"}, {3, "cid"}}) {
+		t.Error(`DifferenceBy(userID, users, []user{{1, "x"}}) != []user{{2, "bob"}, {3, "cid"}}`)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	if !equal(Intersection([]int{3, 1, 2, 3, 4}, []int{4, 3}), []int{3, 4}) {
+		t.Error("Intersection([]int{3, 1, 2, 3, 4}, []int{4, 3}) != []int{3, 4}")
+	}
+}
+
+func TestIntersectionBy(t *testing.T) {
+	result := IntersectionBy(userID, users, []user{{1, "x"}, {3, "y"}})
+	if !equal(result, []user{{1, "ann"}, {3, "cid"}}) {
+		t.Error(`IntersectionBy(userID, users, []user{{1, "x"}, {3, "y"}}) != []user{{1, "ann"}, {3, "cid"}}`)
+	}
+}
+
+func TestIsDisjoint(t *testing.T) {
+	if !IsDisjoint([]int{1, 2}, []int{3, 4}) {
+		t.Error("IsDisjoint([]int{1, 2}, []int{3, 4}) != true")
+	}
+	if IsDisjoint([]int{1, 2}, []int{2, 3}) {
+		t.Error("IsDisjoint([]int{1, 2}, []int{2, 3}) != false")
+	}
+}
+
+func TestIsDisjointBy(t *testing.T) {
+	if IsDisjointBy(userID, users, []user{{3, "x"}}) {
+		t.Error(`IsDisjointBy(userID, users, []user{{3, "x"}}) != false`)
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	if !IsSubset([]int{2, 2, 1}, []int{1, 2, 3}) {
+		t.Error("IsSubset([]int{2, 2, 1}, []int{1, 2, 3}) != true")
+	}
+	if IsSubset([]int{1, 4}, []int{1, 2, 3}) {
+		t.Error("IsSubset([]int{1, 4}, []int{1, 2, 3}) != false")
+	}
+}
+
+func TestIsSubsetBy(t *testing.T) {
+	if !IsSubsetBy(userID, []user{{1, "x"}, {2, "y"}}, users) {
+		t.Error(`IsSubsetBy(userID, []user{{1, "x"}, {2, "y"}}, users) != true`)
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	if !equal(Subtract([]int{1, 2, 3, 2, 1, 2}, []int{2, 1, 2}), []int{3, 1, 2}) {
+		t.Error("Subtract([]int{1, 2, 3, 2, 1, 2}, []int{2, 1, 2}) != []int{3, 1, 2}")
+	}
+	if !equal(Subtract([]int{1, 2}, []int{3}), []int{1, 2}) {
+		t.Error("Subtract([]int{1, 2}, []int{3}) != []int{1, 2}")
+	}
+}
+
+func TestSubtractBy(t *testing.T) {
+	result := SubtractBy(userID, users, []user{{1, "x"}})
+	if !equal(result, []user{{2, "bob"}, {3, "cid"}, {1, "amy"}}) {
+		t.Error(`SubtractBy(userID, users, []user{{1, "x"}}) != []user{{2, "bob"}, {3, "cid"}, {1, "amy"}}`)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	if !equal(SymmetricDifference([]int{1, 2, 3}, []int{4, 3, 2}), []int{1, 4}) {
+		t.Error("SymmetricDifference([]int{1, 2, 3}, []int{4, 3, 2}) != []int{1, 4}")
+	}
+	if result := SymmetricDifference([]int{}, []int{}); result == nil || len(result) != 0 {
+		t.Error("SymmetricDifference([]int{}, []int{}) != []int{}")
+	}
+}
+
+func TestSymmetricDifferenceBy(t *testing.T) {
+	result := SymmetricDifferenceBy(userID, []user{{1, "a"}, {2, "b"}}, []user{{2, "c"}, {3, "d"}})
+	if !equal(result, []user{{1, "a"}, {3, "d"}}) {
+		t.Error(`SymmetricDifferenceBy(userID, ...) != []user{{1, "a"}, {3, "d"}}`)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	if !equal(Union([]int{3, 1, 3}, []int{2, 1, 4}), []int{3, 1, 2, 4}) {
+		t.Error("Union([]int{3, 1, 3}, []int{2, 1, 4}) != []int{3, 1, 2, 4}")
+	}
+}
+
+func TestUnionBy(t *testing.T) {
+	result := UnionBy(userID, []user{{1, "a"}}, []user{{1, "b"}, {2, "c"}})
+	if !equal(result, []user{{1, "a"}, {2, "c"}}) {
+		t.Error(`UnionBy(userID, []user{{1, "a"}}, []user{{1, "b"}, {2, "c"}}) != []user{{1, "a"}, {2, "c"}}`)
+	}
+}
@@ -0,0 +1,63 @@
+package lists
+
+import (
+	"constraints"
+)
+
+// IsSorted returns true if the elements of list are in non-decreasing order, otherwise false.
+func IsSorted[T constraints.Ordered](list []T) bool {
+	return IsSortedFunc(compare[T], list)
+}
+
+// IsSortedFunc is like IsSorted, but the elements are ordered by cmp, as in MergeFunc.
+func IsSortedFunc[T any](cmp func(a, b T) int, list []T) bool {
+	for i := 1; i < len(list); i++ {
+		if cmp(list[i-1], list[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsStrictlySorted returns true if the elements of list are in increasing order and contain no duplicates, otherwise false.
+func IsStrictlySorted[T constraints.Ordered](list []T) bool {
+	for i := 1; i < len(list); i++ {
+		if compare(list[i-1], list[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort returns a list containing the sorted elements of list. The sort is stable: elements comparing equal keep their relative order.
+func Sort[T constraints.Ordered](list []T) []T {
+	return merge(compare[T], false, runs(compare[T], list))
+}
+
+// SortFunc is like Sort, but the elements are ordered by cmp, as in MergeFunc.
+func SortFunc[T any](cmp func(a, b T) int, list []T) []T {
+	return merge(cmp, false, runs(cmp, list))
+}
+
+// USort returns a list containing the sorted elements of list where all except the first element of the elements comparing equal have been deleted.
+func USort[T constraints.Ordered](list []T) []T {
+	return merge(compare[T], true, runs(compare[T], list))
+}
+
+// USortFunc is like USort, but the elements are ordered by cmp, as in MergeFunc.
+func USortFunc[T any](cmp func(a, b T) int, list []T) []T {
+	return merge(cmp, true, runs(cmp, list))
+}
+
+// runs splits list into its maximal non-decreasing runs, which are then merged back together by the sort functions. The runs share the backing array of list.
+func runs[T any](cmp func(a, b T) int, list []T) [][]T {
+	var newList [][]T
+	start := 0
+	for i := 1; i <= len(list); i++ {
+		if i == len(list) || cmp(list[i-1], list[i]) > 0 {
+			newList = append(newList, list[start:i])
+			start = i
+		}
+	}
+	return newList
+}
@@ -0,0 +1,90 @@
+package lists
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIsSorted(t *testing.T) {
+	if !IsSorted([]int{1, 2, 2, 3}) {
+		t.Error("IsSorted([]int{1, 2, 2, 3}) != true")
+	}
+	if IsSorted([]int{1, 3, 2}) {
+		t.Error("IsSorted([]int{1, 3, 2}) != false")
+	}
+	if !IsSorted([]int{}) {
+		t.Error("IsSorted([]int{}) != true")
+	}
+}
+
+func TestIsSortedFunc(t *testing.T) {
+	desc := func(a, b int) int { return b - a }
+	if !IsSortedFunc(desc, []int{3, 2, 2, 1}) {
+		t.Error("IsSortedFunc(desc, []int{3, 2, 2, 1}) != true")
+	}
+	if IsSortedFunc(desc, []int{1, 2}) {
+		t.Error("IsSortedFunc(desc, []int{1, 2}) != false")
+	}
+}
+
+func TestIsStrictlySorted(t *testing.T) {
+	if !IsStrictlySorted([]string{"a", "b", "c"}) {
+		t.Error(`IsStrictlySorted([]string{"a", "b", "c"}) != true`)
+	}
+	if IsStrictlySorted([]string{"a", "b", "b"}) {
+		t.Error(`IsStrictlySorted([]string{"a", "b", "b"}) != false`)
+	}
+}
+
+func TestSort(t *testing.T) {
+	list := []int{5, 3, 9, 1, 3, 7, 2}
+	if !equal(Sort(list), []int{1, 2, 3, 3, 5, 7, 9}) {
+		t.Error("Sort([]int{5, 3, 9, 1, 3, 7, 2}) != []int{1, 2, 3, 3, 5, 7, 9}")
+	}
+	if list[0] != 5 {
+		t.Error("Sort([]int{5, 3, 9, 1, 3, 7, 2}) modified its argument")
+	}
+	if !equal(Sort([]string{"b", "c", "a"}), []string{"a", "b", "c"}) {
+		t.Error(`Sort([]string{"b", "c", "a"}) != []string{"a", "b", "c"}`)
+	}
+	if s := Sort([]int{}); s == nil || len(s) != 0 {
+		t.Error("Sort([]int{}) != []int{}")
+	}
+	nan := math.NaN()
+	s := Sort([]float64{3, nan, 1, nan, 2})
+	if len(s) != 5 || !math.IsNaN(s[0]) || !math.IsNaN(s[1]) || !equal(s[2:], []float64{1, 2, 3}) {
+		t.Errorf("Sort([]float64{3, NaN, 1, NaN, 2}) = %v, want [NaN NaN 1 2 3]", s)
+	}
+	if !IsSorted(s) || IsSorted([]float64{3, nan, 1, 2}) {
+		t.Error("IsSorted does not order NaN before other values")
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	type item struct{ key, pos int }
+	list := []item{{3, 0}, {1, 1}, {3, 2}, {2, 3}, {1, 4}}
+	result := SortFunc(func(a, b item) int { return a.key - b.key }, list)
+	want := []item{{1, 1}, {1, 4}, {2, 3}, {3, 0}, {3, 2}}
+	if !equal(result, want) {
+		t.Errorf("SortFunc(byKey, list) = %v, want %v", result, want)
+	}
+}
+
+func TestUSort(t *testing.T) {
+	if !equal(USort([]int{3, 1, 2, 3, 1}), []int{1, 2, 3}) {
+		t.Error("USort([]int{3, 1, 2, 3, 1}) != []int{1, 2, 3}")
+	}
+	u := USort([]float64{2, math.NaN(), 1, math.NaN()})
+	if len(u) != 3 || !math.IsNaN(u[0]) || !equal(u[1:], []float64{1, 2}) {
+		t.Errorf("USort([]float64{2, NaN, 1, NaN}) = %v, want [NaN 1 2]", u)
+	}
+}
+
+func TestUSortFunc(t *testing.T) {
+	type item struct{ key, pos int }
+	list := []item{{2, 0}, {1, 1}, {2, 2}, {1, 3}}
+	result := USortFunc(func(a, b item) int { return a.key - b.key }, list)
+	if !equal(result, []item{{1, 1}, {2, 0}}) {
+		t.Errorf("USortFunc(byKey, list) = %v, want [{1 1} {2 0}]", result)
+	}
+}
@@ -0,0 +1,75 @@
+package lists
+
+// Fields is like SplitOn, but empty parts are dropped, as strings.FieldsFunc does, so separators at the ends of list and consecutive separators produce no parts.
+func Fields[T comparable](sep []T, list []T) [][]T {
+	return Filter(func(part []T) bool { return len(part) > 0 }, SplitOn(sep, list))
+}
+
+// FieldsFunc is like SplitOnFunc, but empty parts are dropped, so that every part is a maximal run of elements for which isSep returns false.
+func FieldsFunc[T any](isSep func(T) bool, list []T) [][]T {
+	return Filter(func(part []T) bool { return len(part) > 0 }, SplitOnFunc(isSep, list))
+}
+
+// Intercalate returns a list in which all the sublists have been appended with sep inserted between each of them. It is the inverse of SplitOn.
+func Intercalate[T any](sep []T, lists [][]T) []T {
+	return Flatten(Join(sep, lists))
+}
+
+// SplitOn splits list into all parts separated by sep and returns the parts between those separators. If sep is empty, list is split into single elements. Separators at the ends of list and consecutive separators produce empty parts, as in strings.Split. The parts share memory with list and are capped at their length, so appending to one never overwrites list.
+func SplitOn[T comparable](sep []T, list []T) [][]T {
+	return SplitOnN(sep, -1, list)
+}
+
+// SplitOnFunc splits list at every element for which isSep returns true and returns the parts between those separators. The separators are not part of the result. The parts share memory with list, as in SplitOn.
+func SplitOnFunc[T any](isSep func(T) bool, list []T) [][]T {
+	return SplitOnFuncN(isSep, -1, list)
+}
+
+// SplitOnFuncN is like SplitOnFunc, but n determines the number of parts to return, as in SplitOnN.
+func SplitOnFuncN[T any](isSep func(T) bool, n int, list []T) [][]T {
+	newList := make([][]T, 0)
+	if n == 0 {
+		return newList
+	}
+	start := 0
+	for i, v := range list {
+		if len(newList) == n-1 {
+			break
+		}
+		if isSep(v) {
+			newList = append(newList, list[start:i:i])
+			start = i + 1
+		}
+	}
+	return append(newList, list[start:len(list):len(list)])
+}
+
+// SplitOnN is like SplitOn, but n determines the number of parts to return: if n is positive, at most n parts are returned and the last part is the unsplit remainder; if n is zero, no parts are returned; if n is negative, all parts are returned, as in strings.SplitN.
+func SplitOnN[T comparable](sep []T, n int, list []T) [][]T {
+	newList := make([][]T, 0)
+	if n == 0 {
+		return newList
+	}
+	if len(sep) == 0 {
+		for i := range list {
+			if len(newList) == n-1 {
+				return append(newList, list[i:len(list):len(list)])
+			}
+			newList = append(newList, list[i:i+1:i+1])
+		}
+		return newList
+	}
+	start := 0
+	kmp(eq[T], sep, list, func(i int) bool {
+		if len(newList) == n-1 {
+			return false
+		}
+		if i >= start {
+			// Matches overlapping the previous separator are skipped.
+			newList = append(newList, list[start:i:i])
+			start = i + len(sep)
+		}
+		return true
+	})
+	return append(newList, list[start:len(list):len(list)])
+}
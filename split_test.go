@@ -0,0 +1,72 @@
+package lists
+
+import (
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	result := Fields([]int{0}, []int{0, 1, 0, 0, 2, 3, 0})
+	if !equalAll(result, [][]int{{1}, {2, 3}}) {
+		t.Error("Fields([]int{0}, []int{0, 1, 0, 0, 2, 3, 0}) != [][]int{{1}, {2, 3}}")
+	}
+}
+
+func TestFieldsFunc(t *testing.T) {
+	result := FieldsFunc(func(b byte) bool { return b == ' ' }, []byte("  ab c "))
+	if len(result) != 2 || string(result[0]) != "ab" || string(result[1]) != "c" {
+		t.Error(`FieldsFunc(isSpace, []byte("  ab c ")) != [][]byte{"ab", "c"}`)
+	}
+}
+
+func TestIntercalate(t *testing.T) {
+	if !equal(Intercalate([]int{0, 0}, [][]int{{1}, {}, {2, 3}}), []int{1, 0, 0, 0, 0, 2, 3}) {
+		t.Error("Intercalate([]int{0, 0}, [][]int{{1}, {}, {2, 3}}) != []int{1, 0, 0, 0, 0, 2, 3}")
+	}
+	list := []int{1, 0, 2, 0, 0, 3}
+	if !equal(Intercalate([]int{0}, SplitOn([]int{0}, list)), list) {
+		t.Error("Intercalate(sep, SplitOn(sep, list)) != list")
+	}
+}
+
+func TestSplitOn(t *testing.T) {
+	result := SplitOn([]int{0, 0}, []int{1, 0, 0, 2, 0, 0, 0, 3, 0, 0})
+	if !equalAll(result, [][]int{{1}, {2}, {0, 3}, {}}) {
+		t.Error("SplitOn([]int{0, 0}, []int{1, 0, 0, 2, 0, 0, 0, 3, 0, 0}) != [][]int{{1}, {2}, {0, 3}, {}}")
+	}
+	if !equalAll(SplitOn([]int{}, []int{1, 2}), [][]int{{1}, {2}}) {
+		t.Error("SplitOn([]int{}, []int{1, 2}) != [][]int{{1}, {2}}")
+	}
+	if !equalAll(SplitOn([]int{9}, []int{}), [][]int{{}}) {
+		t.Error("SplitOn([]int{9}, []int{}) != [][]int{{}}")
+	}
+}
+
+func TestSplitOnFunc(t *testing.T) {
+	result := SplitOnFunc(func(x int) bool { return x < 0 }, []int{1, -1, 2, 3, -2})
+	if !equalAll(result, [][]int{{1}, {2, 3}, {}}) {
+		t.Error("SplitOnFunc(isNegative, []int{1, -1, 2, 3, -2}) != [][]int{{1}, {2, 3}, {}}")
+	}
+}
+
+func TestSplitOnFuncN(t *testing.T) {
+	result := SplitOnFuncN(func(x int) bool { return x < 0 }, 2, []int{1, -1, 2, -2, 3})
+	if !equalAll(result, [][]int{{1}, {2, -2, 3}}) {
+		t.Error("SplitOnFuncN(isNegative, 2, []int{1, -1, 2, -2, 3}) != [][]int{{1}, {2, -2, 3}}")
+	}
+	if len(SplitOnFuncN(func(x int) bool { return x < 0 }, 0, []int{1})) != 0 {
+		t.Error("SplitOnFuncN(isNegative, 0, []int{1}) != [][]int{}")
+	}
+}
+
+func TestSplitOnN(t *testing.T) {
+	result := SplitOnN([]string{","}, 2, []string{"a", ",", "b", ",", "c"})
+	if !equalAll(result, [][]string{{"a"}, {"b", ",", "c"}}) {
+		t.Error(`SplitOnN([]string{","}, 2, []string{"a", ",", "b", ",", "c"}) != [][]string{{"a"}, {"b", ",", "c"}}`)
+	}
+	if !equalAll(SplitOnN([]int{}, 2, []int{1, 2, 3}), [][]int{{1}, {2, 3}}) {
+		t.Error("SplitOnN([]int{}, 2, []int{1, 2, 3}) != [][]int{{1}, {2, 3}}")
+	}
+	if !equalAll(SplitOnN([]int{0}, 1, []int{1, 0, 2}), [][]int{{1, 0, 2}}) {
+		t.Error("SplitOnN([]int{0}, 1, []int{1, 0, 2}) != [][]int{{1, 0, 2}}")
+	}
+}
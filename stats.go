@@ -0,0 +1,222 @@
+package lists
+
+import (
+	"constraints"
+	"math"
+)
+
+// Interpolation selects how Quantile and Percentiles compute a quantile that falls between two elements of the sorted list.
+type Interpolation int
+
+const (
+	// Linear interpolates linearly between the two closest elements.
+	Linear Interpolation = iota
+	// Lower picks the lower of the two closest elements.
+	Lower
+	// Higher picks the higher of the two closest elements.
+	Higher
+	// Nearest picks the closest element, rounding half to even.
+	Nearest
+	// Midpoint picks the mean of the two closest elements.
+	Midpoint
+)
+
+// Bin is a histogram bin holding the number of elements in the half-open interval [Low, High). The last bin of a histogram also holds the elements equal to its High.
+type Bin struct {
+	Low, High float64
+	Count     int
+}
+
+// Histogram counts the elements of list in n bins of equal width spanning from the smallest to the largest finite element. NaN and infinite elements are not counted, since no bin of finite width can hold them. If the elements are so close together that some edges round to the same float64, the duplicate edges are dropped and fewer than n bins are returned. Returns false if n is not positive or list has no finite element.
+func Histogram[T Number](n int, list []T) ([]Bin, bool) {
+	min, max, ok := MinMax(Filter(func(v T) bool { return finite(float64(v)) }, list))
+	if n <= 0 || !ok {
+		return nil, false
+	}
+	low, high := float64(min), float64(max)
+	if low == high {
+		low, high = low-0.5, high+0.5
+		if low == high {
+			// 0.5 is below the precision of the element, so widen by one representable value on each side that has one.
+			low, high = math.Nextafter(low, -math.MaxFloat64), math.Nextafter(high, math.MaxFloat64)
+		}
+	}
+	// Half the span cannot overflow, even from -math.MaxFloat64 to math.MaxFloat64, and adding it twice keeps the edges in increasing order.
+	half := high/2 - low/2
+	edges := make([]float64, n+1)
+	for i := range edges {
+		step := half * (float64(i) / float64(n))
+		edges[i] = math.Min(low+step+step, high)
+	}
+	edges[n] = high
+	return HistogramEdges(Dedup(edges), list)
+}
+
+// HistogramEdges counts the elements of list in the bins delimited by edges, which must be finite, sorted in increasing order and contain no duplicates. Elements outside [edges[0], edges[len(edges)-1]] and NaN elements are not counted. Returns false if there are fewer than two edges, they are not sorted or any of them is infinite or NaN.
+func HistogramEdges[T Number](edges []float64, list []T) ([]Bin, bool) {
+	if len(edges) < 2 || !All(finite[float64], edges) || !IsStrictlySorted(edges) {
+		return nil, false
+	}
+	bins := make([]Bin, len(edges)-1)
+	for i := range bins {
+		bins[i] = Bin{Low: edges[i], High: edges[i+1]}
+	}
+	last := edges[len(edges)-1]
+	for _, v := range list {
+		x := float64(v)
+		if math.IsNaN(x) || x < edges[0] || x > last {
+			continue
+		}
+		// Find the first edge greater than x; the bin starts at the edge before it.
+		lo, hi := 0, len(edges)
+		for lo < hi {
+			mid := int(uint(lo+hi) >> 1)
+			if edges[mid] <= x {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		i := lo - 1
+		if i == len(bins) {
+			i--
+		}
+		bins[i].Count++
+	}
+	return bins, true
+}
+
+// Median returns the middle element of list once sorted, or the mean of the two middle elements if list has an even number of elements. NaN elements are skipped, as in Histogram. Returns false if list has no element other than NaN.
+func Median[T Number](list []T) (float64, bool) {
+	return Quantile(0.5, Midpoint, list)
+}
+
+// MinMax returns the first element of list that compares less than or equal to all other elements and the first element that compares greater than or equal to all other elements, in a single pass. Returns false if list is empty.
+func MinMax[T constraints.Ordered](list []T) (T, T, bool) {
+	if len(list) == 0 {
+		var empty T
+		return empty, empty, false
+	}
+	min, max := list[0], list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max, true
+}
+
+// Mode returns the most frequent element of list. When several elements are equally frequent, the one that occurs first in list is returned. Returns false if list is empty.
+func Mode[T comparable](list []T) (T, bool) {
+	var mode T
+	counts := make(map[T]int)
+	best := 0
+	for _, v := range list {
+		counts[v]++
+	}
+	for _, v := range list {
+		if counts[v] > best {
+			mode, best = v, counts[v]
+		}
+	}
+	return mode, best > 0
+}
+
+// Percentiles returns the percentiles ps, given in the range 0 to 100, of list, as in Quantile. Returns false if list has no element other than NaN or any of ps is out of range.
+func Percentiles[T Number](ps []float64, method Interpolation, list []T) ([]float64, bool) {
+	sorted := sortNumbers(list)
+	if len(sorted) == 0 {
+		return nil, false
+	}
+	newList := make([]float64, len(ps))
+	for i, p := range ps {
+		q, ok := quantile(p/100, method, sorted)
+		if !ok {
+			return nil, false
+		}
+		newList[i] = q
+	}
+	return newList, true
+}
+
+// Quantile returns the q-th quantile of list, where q is in the range 0 to 1. When the quantile falls between two elements, method decides which value is returned. NaN elements are skipped, as in Histogram. Returns false if list has no element other than NaN or q is out of range.
+func Quantile[T Number](q float64, method Interpolation, list []T) (float64, bool) {
+	return quantile(q, method, sortNumbers(list))
+}
+
+// SampleStdDev returns the sample standard deviation of list. Returns false if list has fewer than two elements.
+func SampleStdDev[T Number](list []T) (float64, bool) {
+	v, ok := SampleVariance(list)
+	return math.Sqrt(v), ok
+}
+
+// SampleVariance returns the sample variance of list, using Bessel's correction. Returns false if list has fewer than two elements.
+func SampleVariance[T Number](list []T) (float64, bool) {
+	if len(list) < 2 {
+		return 0, false
+	}
+	return welford(list) / float64(len(list)-1), true
+}
+
+// StdDev returns the population standard deviation of list. Returns false if list is empty.
+func StdDev[T Number](list []T) (float64, bool) {
+	v, ok := Variance(list)
+	return math.Sqrt(v), ok
+}
+
+// Variance returns the population variance of list. Returns false if list is empty.
+func Variance[T Number](list []T) (float64, bool) {
+	if len(list) == 0 {
+		return 0, false
+	}
+	return welford(list) / float64(len(list)), true
+}
+
+// quantile is Quantile for an already sorted list.
+func quantile[T Number](q float64, method Interpolation, sorted []T) (float64, bool) {
+	if len(sorted) == 0 || !(q >= 0 && q <= 1) {
+		return 0, false
+	}
+	pos := q * float64(len(sorted)-1)
+	i := int(pos)
+	frac := pos - float64(i)
+	lo := float64(sorted[i])
+	if frac == 0 {
+		return lo, true
+	}
+	hi := float64(sorted[i+1])
+	switch method {
+	case Lower:
+		return lo, true
+	case Higher:
+		return hi, true
+	case Nearest:
+		if frac < 0.5 || frac == 0.5 && i%2 == 0 {
+			return lo, true
+		}
+		return hi, true
+	case Midpoint:
+		return (lo + hi) / 2, true
+	}
+	return lo + (hi-lo)*frac, true
+}
+
+// sortNumbers returns a sorted copy of list without its NaN elements.
+func sortNumbers[T Number](list []T) []T {
+	return Sort(Filter(func(v T) bool { return v == v }, list))
+}
+
+// welford returns the sum of squared deviations from the mean of list, computed in a single numerically stable pass.
+func welford[T Number](list []T) float64 {
+	var mean, m2 float64
+	for i, v := range list {
+		x := float64(v)
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return m2
+}
@@ -0,0 +1,167 @@
+package lists
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	bins, ok := Histogram(2, []int{1, 2, 3, 4, 5})
+	if !ok || len(bins) != 2 || bins[0] != (Bin{1, 3, 2}) || bins[1] != (Bin{3, 5, 3}) {
+		t.Errorf("Histogram(2, []int{1, 2, 3, 4, 5}) = %v, want [{1 3 2} {3 5 3}]", bins)
+	}
+	bins, ok = Histogram(1, []int{7, 7})
+	if !ok || bins[0] != (Bin{6.5, 7.5, 2}) {
+		t.Errorf("Histogram(1, []int{7, 7}) = %v, want [{6.5 7.5 2}]", bins)
+	}
+	bins, ok = Histogram(2, []float64{math.NaN(), 1, math.NaN(), 2})
+	if !ok || len(bins) != 2 || bins[0].Count != 1 || bins[1].Count != 1 {
+		t.Errorf("Histogram(2, []float64{NaN, 1, NaN, 2}) = %v, want one element per bin", bins)
+	}
+	bins, ok = Histogram(10, []float64{1e16, 1e16 + 2})
+	if !ok || len(bins) != 1 || bins[0].Count != 2 {
+		t.Errorf("Histogram(10, []float64{1e16, 1e16 + 2}) = %v, want a single bin holding both elements", bins)
+	}
+	bins, ok = Histogram(1, []float64{1e17, 1e17})
+	if !ok || len(bins) != 1 || bins[0].Count != 2 {
+		t.Errorf("Histogram(1, []float64{1e17, 1e17}) = %v, want a single bin holding both elements", bins)
+	}
+	bins, ok = Histogram(2, []float64{1, math.Inf(1), 3, math.Inf(-1)})
+	if !ok || len(bins) != 2 || bins[0] != (Bin{1, 2, 1}) || bins[1] != (Bin{2, 3, 1}) {
+		t.Errorf("Histogram(2, []float64{1, +Inf, 3, -Inf}) = %v, want [{1 2 1} {2 3 1}]", bins)
+	}
+	bins, ok = Histogram(4, []float64{-math.MaxFloat64, math.MaxFloat64})
+	if !ok || len(bins) != 4 || bins[0].Count != 1 || bins[3].Count != 1 {
+		t.Errorf("Histogram(4, []float64{-MaxFloat64, MaxFloat64}) = %v, want one element in each outer bin", bins)
+	}
+	if _, ok = Histogram(2, []float64{math.Inf(1), math.NaN()}); ok {
+		t.Error("Histogram(2, []float64{+Inf, NaN}) != false")
+	}
+	if _, ok = Histogram(0, []int{1}); ok {
+		t.Error("Histogram(0, []int{1}) != false")
+	}
+	if _, ok = Histogram(3, []int{}); ok {
+		t.Error("Histogram(3, []int{}) != false")
+	}
+}
+
+func TestHistogramEdges(t *testing.T) {
+	bins, ok := HistogramEdges([]float64{0, 10, 100}, []float64{-1, 0, 5, 10, 99.5, 100, 101})
+	if !ok || bins[0].Count != 2 || bins[1].Count != 3 {
+		t.Errorf("HistogramEdges([]float64{0, 10, 100}, ...) = %v, want counts 2 and 3", bins)
+	}
+	if _, ok = HistogramEdges([]float64{0, 0}, []int{1}); ok {
+		t.Error("HistogramEdges([]float64{0, 0}, []int{1}) != false")
+	}
+	if _, ok = HistogramEdges([]float64{math.NaN(), 0, 1}, []float64{-5, 0.5}); ok {
+		t.Error("HistogramEdges([]float64{NaN, 0, 1}, []float64{-5, 0.5}) != false")
+	}
+	if _, ok = HistogramEdges([]float64{0, math.Inf(1)}, []float64{1}); ok {
+		t.Error("HistogramEdges([]float64{0, +Inf}, []float64{1}) != false")
+	}
+}
+
+func TestMedian(t *testing.T) {
+	m, ok := Median([]int{5, 1, 3})
+	if !ok || m != 3 {
+		t.Error("Median([]int{5, 1, 3}) != 3")
+	}
+	m, ok = Median([]int{4, 1, 3, 2})
+	if !ok || m != 2.5 {
+		t.Error("Median([]int{4, 1, 3, 2}) != 2.5")
+	}
+	m, ok = Median([]float64{3, math.NaN(), 1, 2, 4})
+	if !ok || m != 2.5 {
+		t.Errorf("Median([]float64{3, NaN, 1, 2, 4}) = %v, want 2.5 with NaN skipped", m)
+	}
+	if _, ok = Median([]float64{math.NaN()}); ok {
+		t.Error("Median([]float64{NaN}) != false")
+	}
+	if _, ok = Median([]int{}); ok {
+		t.Error("Median([]int{}) != false")
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	min, max, ok := MinMax([]int{3, 1, 4, 1, 5})
+	if !ok || min != 1 || max != 5 {
+		t.Error("MinMax([]int{3, 1, 4, 1, 5}) != 1, 5")
+	}
+	if _, _, ok = MinMax([]string{}); ok {
+		t.Error("MinMax([]string{}) != false")
+	}
+}
+
+func TestMode(t *testing.T) {
+	m, ok := Mode([]string{"b", "a", "a", "b", "c"})
+	if !ok || m != "b" {
+		t.Error(`Mode([]string{"b", "a", "a", "b", "c"}) != "b"`)
+	}
+	if _, ok = Mode([]int{}); ok {
+		t.Error("Mode([]int{}) != false")
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	ps, ok := Percentiles([]float64{0, 25, 50, 100}, Linear, []int{1, 2, 3, 4, 5})
+	if !ok || !equal(ps, []float64{1, 2, 3, 5}) {
+		t.Errorf("Percentiles([]float64{0, 25, 50, 100}, Linear, []int{1, 2, 3, 4, 5}) = %v, want [1 2 3 5]", ps)
+	}
+	if _, ok = Percentiles([]float64{101}, Linear, []int{1}); ok {
+		t.Error("Percentiles([]float64{101}, Linear, []int{1}) != false")
+	}
+	ps, ok = Percentiles([]float64{0, 100}, Linear, []float64{math.NaN(), 2, 1})
+	if !ok || !equal(ps, []float64{1, 2}) {
+		t.Errorf("Percentiles([]float64{0, 100}, Linear, []float64{NaN, 2, 1}) = %v, want [1 2]", ps)
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	list := []int{4, 1, 3, 2}
+	for _, c := range []struct {
+		method Interpolation
+		want   float64
+	}{{Linear, 1.75}, {Lower, 1}, {Higher, 2}, {Nearest, 2}, {Midpoint, 1.5}} {
+		q, ok := Quantile(0.25, c.method, list)
+		if !ok || q != c.want {
+			t.Errorf("Quantile(0.25, %d, []int{4, 1, 3, 2}) = %v, want %v", c.method, q, c.want)
+		}
+	}
+	if _, ok := Quantile(math.NaN(), Linear, list); ok {
+		t.Error("Quantile(NaN, Linear, list) != false")
+	}
+}
+
+func TestSampleStdDev(t *testing.T) {
+	s, ok := SampleStdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	if !ok || math.Abs(s-math.Sqrt(32.0/7)) > 1e-12 {
+		t.Error("SampleStdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9}) != sqrt(32/7)")
+	}
+}
+
+func TestSampleVariance(t *testing.T) {
+	v, ok := SampleVariance([]int{1, 2, 3, 4})
+	if !ok || math.Abs(v-5.0/3) > 1e-12 {
+		t.Error("SampleVariance([]int{1, 2, 3, 4}) != 5/3")
+	}
+	if _, ok = SampleVariance([]int{1}); ok {
+		t.Error("SampleVariance([]int{1}) != false")
+	}
+}
+
+func TestStdDev(t *testing.T) {
+	s, ok := StdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	if !ok || s != 2 {
+		t.Error("StdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9}) != 2")
+	}
+}
+
+func TestVariance(t *testing.T) {
+	v, ok := Variance([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16})
+	if !ok || v != 22.5 {
+		t.Error("Variance([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}) != 22.5")
+	}
+	if _, ok = Variance([]int{}); ok {
+		t.Error("Variance([]int{}) != false")
+	}
+}
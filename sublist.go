@@ -0,0 +1,150 @@
+package lists
+
+// AllIndicesOfSublist returns the positions in list of all occurrences of sub, including overlapping ones, in increasing order. The search takes linear time.
+func AllIndicesOfSublist[T comparable](sub, list []T) []int {
+	return AllIndicesOfSublistFunc(eq[T], sub, list)
+}
+
+// AllIndicesOfSublistFunc is like AllIndicesOfSublist, but elements are compared with eq.
+func AllIndicesOfSublistFunc[T any](eq func(a, b T) bool, sub, list []T) []int {
+	newList := make([]int, 0)
+	kmp(eq, sub, list, func(i int) bool {
+		newList = append(newList, i)
+		return true
+	})
+	return newList
+}
+
+// ContainsSublist returns true if sub occurs as a contiguous sublist of list, otherwise false. It is Infix under the name and argument order of IndexOfSublist.
+func ContainsSublist[T comparable](sub, list []T) bool {
+	return Infix(sub, list)
+}
+
+// ContainsSublistFunc is like ContainsSublist, but elements are compared with eq.
+func ContainsSublistFunc[T any](eq func(a, b T) bool, sub, list []T) bool {
+	return InfixFunc(eq, sub, list)
+}
+
+// IndexOfSublist returns the position of the first occurrence of sub in list, if there is one. The search takes linear time.
+func IndexOfSublist[T comparable](sub, list []T) (int, bool) {
+	return IndexOfSublistFunc(eq[T], sub, list)
+}
+
+// IndexOfSublistFunc is like IndexOfSublist, but elements are compared with eq.
+func IndexOfSublistFunc[T any](eq func(a, b T) bool, sub, list []T) (int, bool) {
+	index, found := -1, false
+	kmp(eq, sub, list, func(i int) bool {
+		index, found = i, true
+		return false
+	})
+	return index, found
+}
+
+// Infix returns true if list1 is a contiguous sublist of list2, otherwise false.
+func Infix[T comparable](list1 []T, list2 []T) bool {
+	_, ok := IndexOfSublist(list1, list2)
+	return ok
+}
+
+// InfixFunc is like Infix, but elements are compared with eq.
+func InfixFunc[T any](eq func(a, b T) bool, list1 []T, list2 []T) bool {
+	_, ok := IndexOfSublistFunc(eq, list1, list2)
+	return ok
+}
+
+// Inits returns all prefixes of list, from the empty list up to list itself. The prefixes share memory with list.
+func Inits[T any](list []T) [][]T {
+	newList := make([][]T, len(list)+1)
+	for i := range newList {
+		newList[i] = list[:i:i]
+	}
+	return newList
+}
+
+// StripPrefix returns the remainder of list after prefix if prefix is a prefix of list, otherwise false.
+func StripPrefix[T comparable](prefix, list []T) ([]T, bool) {
+	return StripPrefixFunc(eq[T], prefix, list)
+}
+
+// StripPrefixFunc is like StripPrefix, but elements are compared with eq.
+func StripPrefixFunc[T any](eq func(a, b T) bool, prefix, list []T) ([]T, bool) {
+	if len(prefix) > len(list) {
+		return nil, false
+	}
+	for i, v := range prefix {
+		if !eq(v, list[i]) {
+			return nil, false
+		}
+	}
+	return list[len(prefix):], true
+}
+
+// StripSuffix returns the part of list before suffix if suffix is a suffix of list, otherwise false.
+func StripSuffix[T comparable](suffix, list []T) ([]T, bool) {
+	return StripSuffixFunc(eq[T], suffix, list)
+}
+
+// StripSuffixFunc is like StripSuffix, but elements are compared with eq.
+func StripSuffixFunc[T any](eq func(a, b T) bool, suffix, list []T) ([]T, bool) {
+	if len(suffix) > len(list) {
+		return nil, false
+	}
+	n := len(list) - len(suffix)
+	for i, v := range suffix {
+		if !eq(v, list[n+i]) {
+			return nil, false
+		}
+	}
+	return list[:n:n], true
+}
+
+// Tails returns all suffixes of list, from list itself down to the empty list. The suffixes share memory with list.
+func Tails[T any](list []T) [][]T {
+	newList := make([][]T, len(list)+1)
+	for i := range newList {
+		newList[i] = list[i:]
+	}
+	return newList
+}
+
+// eq is the natural equality of a comparable type, in the form expected by the Func variants.
+func eq[T comparable](a, b T) bool {
+	return a == b
+}
+
+// kmp calls found with the position of every occurrence of sub in list, in increasing order, until found returns false. It uses the Knuth-Morris-Pratt algorithm, so the search takes O(len(sub)+len(list)) calls to eq.
+func kmp[T any](eq func(a, b T) bool, sub, list []T, found func(int) bool) {
+	if len(sub) == 0 {
+		for i := 0; i <= len(list); i++ {
+			if !found(i) {
+				return
+			}
+		}
+		return
+	}
+	// fail[i] is the length of the longest proper prefix of sub[:i+1] that is also a suffix of it.
+	fail := make([]int, len(sub))
+	for i, k := 1, 0; i < len(sub); i++ {
+		for k > 0 && !eq(sub[i], sub[k]) {
+			k = fail[k-1]
+		}
+		if eq(sub[i], sub[k]) {
+			k++
+		}
+		fail[i] = k
+	}
+	for i, k := 0, 0; i < len(list); i++ {
+		for k > 0 && !eq(list[i], sub[k]) {
+			k = fail[k-1]
+		}
+		if eq(list[i], sub[k]) {
+			k++
+		}
+		if k == len(sub) {
+			if !found(i - k + 1) {
+				return
+			}
+			k = fail[k-1]
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package lists
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAllIndicesOfSublist(t *testing.T) {
+	if !equal(AllIndicesOfSublist([]int{1, 1}, []int{1, 1, 1, 2, 1, 1}), []int{0, 1, 4}) {
+		t.Error("AllIndicesOfSublist([]int{1, 1}, []int{1, 1, 1, 2, 1, 1}) != []int{0, 1, 4}")
+	}
+	if !equal(AllIndicesOfSublist([]int{}, []int{1, 2}), []int{0, 1, 2}) {
+		t.Error("AllIndicesOfSublist([]int{}, []int{1, 2}) != []int{0, 1, 2}")
+	}
+}
+
+func TestAllIndicesOfSublistFunc(t *testing.T) {
+	result := AllIndicesOfSublistFunc(strings.EqualFold, []string{"a", "b"}, []string{"A", "b", "a", "B"})
+	if !equal(result, []int{0, 2}) {
+		t.Error(`AllIndicesOfSublistFunc(strings.EqualFold, []string{"a", "b"}, []string{"A", "b", "a", "B"}) != []int{0, 2}`)
+	}
+}
+
+func TestContainsSublist(t *testing.T) {
+	if !ContainsSublist([]int{2, 3}, []int{1, 2, 3, 4}) {
+		t.Error("ContainsSublist([]int{2, 3}, []int{1, 2, 3, 4}) != true")
+	}
+	if ContainsSublist([]int{3, 2}, []int{1, 2, 3, 4}) {
+		t.Error("ContainsSublist([]int{3, 2}, []int{1, 2, 3, 4}) != false")
+	}
+}
+
+func TestContainsSublistFunc(t *testing.T) {
+	if !ContainsSublistFunc(strings.EqualFold, []string{"B", "c"}, []string{"a", "b", "C"}) {
+		t.Error(`ContainsSublistFunc(strings.EqualFold, []string{"B", "c"}, []string{"a", "b", "C"}) != true`)
+	}
+}
+
+func TestIndexOfSublist(t *testing.T) {
+	i, ok := IndexOfSublist([]int{1, 2, 1, 3}, []int{1, 2, 1, 2, 1, 3})
+	if !ok || i != 2 {
+		t.Error("IndexOfSublist([]int{1, 2, 1, 3}, []int{1, 2, 1, 2, 1, 3}) != 2")
+	}
+	_, ok = IndexOfSublist([]int{3, 1}, []int{1, 2, 1, 3})
+	if ok {
+		t.Error("IndexOfSublist([]int{3, 1}, []int{1, 2, 1, 3}) != false")
+	}
+	_, ok = IndexOfSublist([]int{1, 2, 3}, []int{1, 2})
+	if ok {
+		t.Error("IndexOfSublist([]int{1, 2, 3}, []int{1, 2}) != false")
+	}
+}
+
+func TestIndexOfSublistFunc(t *testing.T) {
+	i, ok := IndexOfSublistFunc(strings.EqualFold, []string{"b", "c"}, []string{"A", "B", "C"})
+	if !ok || i != 1 {
+		t.Error(`IndexOfSublistFunc(strings.EqualFold, []string{"b", "c"}, []string{"A", "B", "C"}) != 1`)
+	}
+}
+
+func TestInfix(t *testing.T) {
+	if !Infix([]int{2, 3}, []int{1, 2, 3, 4}) {
+		t.Error("Infix([]int{2, 3}, []int{1, 2, 3, 4}) != true")
+	}
+	if Infix([]int{2, 4}, []int{1, 2, 3, 4}) {
+		t.Error("Infix([]int{2, 4}, []int{1, 2, 3, 4}) != false")
+	}
+}
+
+func TestInfixFunc(t *testing.T) {
+	if !InfixFunc(strings.EqualFold, []string{"X"}, []string{"a", "x"}) {
+		t.Error(`InfixFunc(strings.EqualFold, []string{"X"}, []string{"a", "x"}) != true`)
+	}
+}
+
+func TestInits(t *testing.T) {
+	if !equalAll(Inits([]int{1, 2}), [][]int{{}, {1}, {1, 2}}) {
+		t.Error("Inits([]int{1, 2}) != [][]int{{}, {1}, {1, 2}}")
+	}
+}
+
+func TestStripPrefix(t *testing.T) {
+	rest, ok := StripPrefix([]int{1, 2}, []int{1, 2, 3})
+	if !ok || !equal(rest, []int{3}) {
+		t.Error("StripPrefix([]int{1, 2}, []int{1, 2, 3}) != []int{3}")
+	}
+	if _, ok = StripPrefix([]int{2}, []int{1, 2, 3}); ok {
+		t.Error("StripPrefix([]int{2}, []int{1, 2, 3}) != false")
+	}
+}
+
+func TestStripPrefixFunc(t *testing.T) {
+	rest, ok := StripPrefixFunc(strings.EqualFold, []string{"A"}, []string{"a", "b"})
+	if !ok || !equal(rest, []string{"b"}) {
+		t.Error(`StripPrefixFunc(strings.EqualFold, []string{"A"}, []string{"a", "b"}) != []string{"b"}`)
+	}
+}
+
+func TestStripSuffix(t *testing.T) {
+	rest, ok := StripSuffix([]int{2, 3}, []int{1, 2, 3})
+	if !ok || !equal(rest, []int{1}) {
+		t.Error("StripSuffix([]int{2, 3}, []int{1, 2, 3}) != []int{1}")
+	}
+	if _, ok = StripSuffix([]int{1, 2, 3, 4}, []int{1, 2, 3}); ok {
+		t.Error("StripSuffix([]int{1, 2, 3, 4}, []int{1, 2, 3}) != false")
+	}
+}
+
+func TestStripSuffixFunc(t *testing.T) {
+	rest, ok := StripSuffixFunc(strings.EqualFold, []string{"B"}, []string{"a", "b"})
+	if !ok || !equal(rest, []string{"a"}) {
+		t.Error(`StripSuffixFunc(strings.EqualFold, []string{"B"}, []string{"a", "b"}) != []string{"a"}`)
+	}
+}
+
+func TestTails(t *testing.T) {
+	if !equalAll(Tails([]int{1, 2}), [][]int{{1, 2}, {2}, {}}) {
+		t.Error("Tails([]int{1, 2}) != [][]int{{1, 2}, {2}, {}}")
+	}
+}
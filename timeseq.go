@@ -0,0 +1,131 @@
+package lists
+
+import (
+	"fmt"
+	"time"
+)
+
+// Step is the distance between consecutive elements of a time sequence. The calendar part (Years, Months and Days) is applied on the wall clock of the location of the sequence, so a step of one day keeps the time of day across daylight saving time transitions, while the Duration part is elapsed time. The fields may be combined and may be negative for a decreasing sequence.
+type Step struct {
+	Years, Months, Days int
+	Duration            time.Duration
+}
+
+// Every returns the Step of d elapsed time.
+func Every(d time.Duration) Step {
+	return Step{Duration: d}
+}
+
+// Days returns the calendar Step of n days.
+func Days(n int) Step {
+	return Step{Days: n}
+}
+
+// Months returns the calendar Step of n months.
+func Months(n int) Step {
+	return Step{Months: n}
+}
+
+// Years returns the calendar Step of n years.
+func Years(n int) Step {
+	return Step{Years: n}
+}
+
+// Date is a calendar date without time of day or location.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// addTo returns t advanced by n steps. Years and months are added first and the day of month is clamped to the length of the resulting month, so that one month after January 31 is the last day of February; then days and the duration are added. Every element of a sequence is computed from its start, so clamping in one element does not shift the following ones. The wall clock is only consulted for the calendar part, so a pure Duration step is exact elapsed time even from an ambiguous local time.
+func (s Step) addTo(t time.Time, n int) time.Time {
+	if n == 0 {
+		return t
+	}
+	if s.Years == 0 && s.Months == 0 && s.Days == 0 {
+		return addDuration(t, n, s.Duration)
+	}
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	months := int(month) - 1 + n*(s.Years*12+s.Months)
+	year += months / 12
+	months %= 12
+	if months < 0 {
+		year--
+		months += 12
+	}
+	month = time.Month(months + 1)
+	if last := daysIn(year, month); day > last {
+		day = last
+	}
+	t = time.Date(year, month, day+n*s.Days, hour, min, sec, t.Nanosecond(), t.Location())
+	return addDuration(t, n, s.Duration)
+}
+
+// addDuration returns t advanced by n times d of elapsed time. The product is taken separately for the seconds and the nanoseconds, since it overflows a time.Duration beyond about 292 years.
+func addDuration(t time.Time, n int, d time.Duration) time.Time {
+	if d == 0 {
+		return t
+	}
+	sec := int64(n) * int64(d/time.Second)
+	nsec := int64(n) * int64(d%time.Second)
+	return time.Unix(t.Unix()+sec, int64(t.Nanosecond())+nsec).In(t.Location())
+}
+
+// SeqDate returns the sequence of dates that starts with from and advances by step until to is reached or passed, as SeqTime does. step must not have a Duration part.
+func SeqDate(from, to Date, step Step) ([]Date, error) {
+	if step.Duration != 0 {
+		return nil, fmt.Errorf("%w: date sequence with a duration step of %v", ErrBadArgument, step.Duration)
+	}
+	times, err := SeqTime(from.time(), to.time(), step)
+	if err != nil {
+		return nil, err
+	}
+	return Map(dateOf, times), nil
+}
+
+// SeqTime returns the sequence of times that starts with from and contains the successive results of advancing by step, until to is reached or passed (in the latter case, to is not an element of the sequence). The elements are in the location of from. Returns an error if step does not move from towards to, or, when from equals to, if step is zero.
+func SeqTime(from, to time.Time, step Step) ([]time.Time, error) {
+	next := step.addTo(from, 1)
+	forward := next.After(from)
+	if !forward && !next.Before(from) {
+		if from.Equal(to) {
+			return []time.Time{from}, nil
+		}
+		return nil, fmt.Errorf("%w: time sequence with a zero step of %+v", ErrBadArgument, step)
+	}
+	if forward && to.Before(from) || !forward && to.After(from) {
+		return nil, fmt.Errorf("%w: time sequence from %v to %v with step %+v", ErrBadArgument, from, to, step)
+	}
+	newList := []time.Time{}
+	prev := from
+	for i := 0; ; i++ {
+		t := step.addTo(from, i)
+		if forward && t.After(to) || !forward && t.Before(to) {
+			return newList, nil
+		}
+		if i > 0 && (forward && !t.After(prev) || !forward && !t.Before(prev)) {
+			// Mixed-sign steps can stall in short months.
+			return nil, fmt.Errorf("%w: time sequence with step %+v does not advance at %v", ErrBadArgument, step, prev)
+		}
+		newList = append(newList, t)
+		prev = t
+	}
+}
+
+// time returns d as midnight UTC, which is free of daylight saving time transitions.
+func (d Date) time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// dateOf returns the date of t in its location.
+func dateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{year, month, day}
+}
+
+// daysIn returns the number of days of month in year.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
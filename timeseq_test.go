@@ -0,0 +1,105 @@
+package lists
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+// berlin returns the Europe/Berlin location from the embedded tzdata, where daylight saving time starts on 2024-03-31 and ends on 2024-10-27.
+func berlin(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestSeqDate(t *testing.T) {
+	d, err := SeqDate(Date{2024, time.January, 31}, Date{2024, time.May, 1}, Months(1))
+	want := []Date{{2024, time.January, 31}, {2024, time.February, 29}, {2024, time.March, 31}, {2024, time.April, 30}}
+	if err != nil || !equal(d, want) {
+		t.Errorf("SeqDate(2024-01-31, 2024-05-01, Months(1)) = %v, want %v", d, want)
+	}
+	d, err = SeqDate(Date{2024, time.March, 2}, Date{2024, time.February, 27}, Days(-2))
+	want = []Date{{2024, time.March, 2}, {2024, time.February, 29}, {2024, time.February, 27}}
+	if err != nil || !equal(d, want) {
+		t.Errorf("SeqDate(2024-03-02, 2024-02-27, Days(-2)) = %v, want %v", d, want)
+	}
+	if _, err = SeqDate(Date{2024, time.March, 2}, Date{2024, time.March, 3}, Every(time.Hour)); !errors.Is(err, ErrBadArgument) {
+		t.Error("SeqDate(..., Every(time.Hour)) did not return ErrBadArgument")
+	}
+}
+
+func TestSeqTime(t *testing.T) {
+	loc := berlin(t)
+	from := time.Date(2024, time.March, 30, 9, 0, 0, 0, loc)
+	to := time.Date(2024, time.April, 1, 9, 0, 0, 0, loc)
+
+	days, err := SeqTime(from, to, Days(1))
+	if err != nil || len(days) != 3 {
+		t.Fatalf("SeqTime(from, to, Days(1)) = %v, %v", days, err)
+	}
+	for _, d := range days {
+		if d.Hour() != 9 || d.Location() != loc {
+			t.Errorf("SeqTime(from, to, Days(1)) contains %v, want 09:00 Berlin time", d)
+		}
+	}
+	if days[1].Sub(days[0]) != 23*time.Hour {
+		t.Errorf("Days(1) across the spring transition lasted %v, want 23h", days[1].Sub(days[0]))
+	}
+
+	hours, err := SeqTime(from, to, Every(24*time.Hour))
+	if err != nil || len(hours) != 2 || hours[1].Hour() != 10 {
+		t.Errorf("SeqTime(from, to, Every(24h)) = %v, want 2 elements ending at 10:00", hours)
+	}
+
+	fall := time.Date(2024, time.October, 27, 1, 30, 0, 0, loc)
+	slots, err := SeqTime(fall, fall.Add(2*time.Hour), Every(30*time.Minute))
+	if err != nil || len(slots) != 5 || slots[2].Hour() != 2 || slots[4].Hour() != 2 {
+		t.Errorf("SeqTime over the fall transition = %v, want 5 half-hour slots with 02:xx twice", slots)
+	}
+
+	ambiguous := time.Date(2024, time.October, 27, 0, 30, 0, 0, time.UTC).In(loc)
+	slots, err = SeqTime(ambiguous, ambiguous.Add(90*time.Minute), Every(30*time.Minute))
+	if err != nil || len(slots) != 4 || !slots[0].Equal(ambiguous) {
+		t.Errorf("SeqTime from 02:30 CEST over the fall transition = %v, want 4 half-hour slots starting at %v", slots, ambiguous)
+	}
+	for i, s := range slots {
+		if d := s.Sub(ambiguous); d != time.Duration(i)*30*time.Minute {
+			t.Errorf("SeqTime from 02:30 CEST: slot %d is %v after the start, want %v", i, d, time.Duration(i)*30*time.Minute)
+		}
+	}
+
+	from2000, to2300 := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2300, time.January, 1, 0, 0, 0, 0, time.UTC)
+	long, err := SeqTime(from2000, to2300, Every(24*time.Hour))
+	if err != nil || len(long) != 109574 || !long[len(long)-1].Equal(to2300) {
+		t.Errorf("SeqTime(2000-01-01, 2300-01-01, Every(24h)) has %d elements, %v, want 109574 ending at 2300-01-01", len(long), err)
+	}
+	long, err = SeqTime(to2300, from2000, Every(-24*time.Hour-time.Nanosecond))
+	if last := to2300.AddDate(0, 0, -109572).Add(-109572); err != nil || len(long) != 109573 || !long[len(long)-1].Equal(last) {
+		t.Errorf("SeqTime(2300-01-01, 2000-01-01, Every(-24h-1ns)) has %d elements, %v, want 109573 ending at %v", len(long), err, last)
+	}
+
+	months, err := SeqTime(time.Date(2023, time.December, 31, 12, 0, 0, 0, loc), time.Date(2024, time.March, 31, 12, 0, 0, 0, loc), Months(1))
+	if err != nil || len(months) != 4 || months[1].Day() != 31 || months[2].Day() != 29 || months[3].Day() != 31 || months[3].Hour() != 12 {
+		t.Errorf("SeqTime(2023-12-31, 2024-03-31, Months(1)) = %v", months)
+	}
+
+	years, err := SeqTime(time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), time.Date(2028, time.March, 1, 0, 0, 0, 0, time.UTC), Years(2))
+	if err != nil || len(years) != 3 || years[1].Day() != 28 || years[2].Day() != 29 {
+		t.Errorf("SeqTime(2024-02-29, 2028-03-01, Years(2)) = %v", years)
+	}
+
+	if _, err = SeqTime(to, from, Days(1)); !errors.Is(err, ErrBadArgument) {
+		t.Error("SeqTime(to, from, Days(1)) did not return ErrBadArgument")
+	}
+	if _, err = SeqTime(from, to, Step{}); !errors.Is(err, ErrBadArgument) {
+		t.Error("SeqTime(from, to, Step{}) did not return ErrBadArgument")
+	}
+	single, err := SeqTime(from, from, Step{})
+	if err != nil || len(single) != 1 {
+		t.Error("SeqTime(from, from, Step{}) != []time.Time{from}")
+	}
+}
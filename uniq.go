@@ -0,0 +1,69 @@
+package lists
+
+// Dedup returns a copy of list where every run of consecutive equal elements is collapsed into its first element. Equal elements that are not adjacent are kept.
+func Dedup[T comparable](list []T) []T {
+	return DedupBy(identity[T], list)
+}
+
+// DedupBy is like Dedup, but two adjacent elements are considered equal when key returns the same value for both.
+func DedupBy[T any, K comparable](key func(T) K, list []T) []T {
+	newList := make([]T, 0, len(list))
+	var last K
+	for i, v := range list {
+		if k := key(v); i == 0 || k != last {
+			newList = append(newList, v)
+			last = k
+		}
+	}
+	return newList
+}
+
+// Duplicates returns the elements that occur more than once in list, each of them once and in the order of their first occurrence.
+func Duplicates[T comparable](list []T) []T {
+	newList := make([]T, 0)
+	for _, f := range Frequencies(list) {
+		if f.Second > 1 {
+			newList = append(newList, f.First)
+		}
+	}
+	return newList
+}
+
+// Frequencies returns every distinct element of list paired with the number of times it occurs, in the order of their first occurrence.
+func Frequencies[T comparable](list []T) []Pair[T, int] {
+	return FrequenciesBy(identity[T], list)
+}
+
+// FrequenciesBy is like Frequencies, but counts the distinct values of key(elem) instead of the elements themselves.
+func FrequenciesBy[T any, K comparable](key func(T) K, list []T) []Pair[K, int] {
+	newList := make([]Pair[K, int], 0)
+	index := make(map[K]int)
+	for _, v := range list {
+		k := key(v)
+		if i, ok := index[k]; ok {
+			newList[i].Second++
+		} else {
+			index[k] = len(newList)
+			newList = append(newList, Pair[K, int]{k, 1})
+		}
+	}
+	return newList
+}
+
+// Uniq returns a copy of list where all except the first element of the elements comparing equal have been deleted. The order of the remaining elements is preserved.
+func Uniq[T comparable](list []T) []T {
+	return UniqBy(identity[T], list)
+}
+
+// UniqBy is like Uniq, but two elements are considered equal when key returns the same value for both.
+func UniqBy[T any, K comparable](key func(T) K, list []T) []T {
+	newList := make([]T, 0)
+	seen := make(map[K]bool)
+	for _, v := range list {
+		if k := key(v); !seen[k] {
+			seen[k] = true
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
@@ -0,0 +1,54 @@
+package lists
+
+import (
+	"testing"
+)
+
+func TestDedup(t *testing.T) {
+	if !equal(Dedup([]int{1, 1, 2, 2, 2, 1, 3, 3}), []int{1, 2, 1, 3}) {
+		t.Error("Dedup([]int{1, 1, 2, 2, 2, 1, 3, 3}) != []int{1, 2, 1, 3}")
+	}
+	if len(Dedup([]int{})) != 0 {
+		t.Error("Dedup([]int{}) != []int{}")
+	}
+}
+
+func TestDedupBy(t *testing.T) {
+	result := DedupBy(func(s string) int { return len(s) }, []string{"a", "b", "cc", "dd", "e"})
+	if !equal(result, []string{"a", "cc", "e"}) {
+		t.Error(`DedupBy(len, []string{"a", "b", "cc", "dd", "e"}) != []string{"a", "cc", "e"}`)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	if !equal(Duplicates([]int{3, 1, 3, 2, 1, 3}), []int{3, 1}) {
+		t.Error("Duplicates([]int{3, 1, 3, 2, 1, 3}) != []int{3, 1}")
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	result := Frequencies([]string{"b", "a", "b", "c", "b"})
+	if !equal(result, []Pair[string, int]{{"b", 3}, {"a", 1}, {"c", 1}}) {
+		t.Error(`Frequencies([]string{"b", "a", "b", "c", "b"}) != []Pair[string, int]{{"b", 3}, {"a", 1}, {"c", 1}}`)
+	}
+}
+
+func TestFrequenciesBy(t *testing.T) {
+	result := FrequenciesBy(func(x int) bool { return x%2 == 0 }, []int{1, 2, 3, 5})
+	if !equal(result, []Pair[bool, int]{{false, 3}, {true, 1}}) {
+		t.Error("FrequenciesBy(isEven, []int{1, 2, 3, 5}) != []Pair[bool, int]{{false, 3}, {true, 1}}")
+	}
+}
+
+func TestUniq(t *testing.T) {
+	if !equal(Uniq([]int{3, 1, 3, 2, 1}), []int{3, 1, 2}) {
+		t.Error("Uniq([]int{3, 1, 3, 2, 1}) != []int{3, 1, 2}")
+	}
+}
+
+func TestUniqBy(t *testing.T) {
+	result := UniqBy(userID, users)
+	if !equal(result, []user{{2, "bob"}, {1, "ann"}, {3, "cid"}}) {
+		t.Error(`UniqBy(userID, users) != []user{{2, "bob"}, {1, "ann"}, {3, "cid"}}`)
+	}
+}
@@ -0,0 +1,179 @@
+package lists
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Interleave returns a list with the first element of each list, then the second element of each list, and so on, stopping as soon as one of the lists runs out of elements.
+func Interleave[T any](lists ...[]T) []T {
+	if len(lists) == 0 {
+		return []T{}
+	}
+	n := len(lists[0])
+	for _, list := range lists[1:] {
+		if len(list) < n {
+			n = len(list)
+		}
+	}
+	newList := make([]T, 0, n*len(lists))
+	for i := 0; i < n; i++ {
+		for _, list := range lists {
+			newList = append(newList, list[i])
+		}
+	}
+	return newList
+}
+
+// RoundRobin is like Interleave, but the lists that run out of elements are skipped and the remaining ones are taken in turn until all elements have been picked.
+func RoundRobin[T any](lists ...[]T) []T {
+	n := 0
+	for _, list := range lists {
+		n += len(list)
+	}
+	newList := make([]T, 0, n)
+	for i := 0; len(newList) < n; i++ {
+		for _, list := range lists {
+			if i < len(list) {
+				newList = append(newList, list[i])
+			}
+		}
+	}
+	return newList
+}
+
+// Unzip unzips a list of pairs into two lists, where the first list contains the first element of each pair, and the second list contains the second element of each pair.
+func Unzip[A any, B any](list []Pair[A, B]) ([]A, []B) {
+	left, right := make([]A, len(list)), make([]B, len(list))
+	for i, v := range list {
+		left[i], right[i] = v.First, v.Second
+	}
+	return left, right
+}
+
+// Unzip3 unzips a list of triples into three lists, where the first list contains the first element of each triple, the second list contains the second element of each triple, and the third list contains the third element of each triple.
+func Unzip3[A any, B any, C any](list []Triple[A, B, C]) ([]A, []B, []C) {
+	first, second, third := make([]A, len(list)), make([]B, len(list)), make([]C, len(list))
+	for i, v := range list {
+		first[i], second[i], third[i] = v.First, v.Second, v.Third
+	}
+	return first, second, third
+}
+
+// Zip zips two lists of equal length into one list of pairs, where the first element of each pair is taken from list1 and the second element is taken from the corresponding element in list2. Returns false if the lists have different lengths.
+func Zip[A any, B any](list1 []A, list2 []B) ([]Pair[A, B], bool) {
+	return ZipWith(pair[A, B], list1, list2)
+}
+
+// Zip3 zips three lists of equal length into one list of triples, as in Zip. Returns false if the lists have different lengths.
+func Zip3[A any, B any, C any](list1 []A, list2 []B, list3 []C) ([]Triple[A, B, C], bool) {
+	return ZipWith3(triple[A, B, C], list1, list2, list3)
+}
+
+// Zip3Longest is like Zip3, but the shorter lists are padded with fill1, fill2 and fill3 up to the length of the longest list.
+func Zip3Longest[A any, B any, C any](fill1 A, fill2 B, fill3 C, list1 []A, list2 []B, list3 []C) []Triple[A, B, C] {
+	return ZipWith3Longest(triple[A, B, C], fill1, fill2, fill3, list1, list2, list3)
+}
+
+// Zip3Shortest is like Zip3, but the longer lists are trimmed to the length of the shortest list.
+func Zip3Shortest[A any, B any, C any](list1 []A, list2 []B, list3 []C) []Triple[A, B, C] {
+	return ZipWith3Shortest(triple[A, B, C], list1, list2, list3)
+}
+
+// ZipLongest is like Zip, but the shorter list is padded with fill1 or fill2 up to the length of the longer list.
+func ZipLongest[A any, B any](fill1 A, fill2 B, list1 []A, list2 []B) []Pair[A, B] {
+	return ZipWithLongest(pair[A, B], fill1, fill2, list1, list2)
+}
+
+// ZipShortest is like Zip, but the longer list is trimmed to the length of the shorter list.
+func ZipShortest[A any, B any](list1 []A, list2 []B) []Pair[A, B] {
+	return ZipWithShortest(pair[A, B], list1, list2)
+}
+
+// ZipWith combines the elements of two lists of equal length into one list. For each pair x, y of list elements from the two lists, the element in the result list is fun(x, y). Returns false if the lists have different lengths.
+func ZipWith[A any, B any, C any](fun func(A, B) C, list1 []A, list2 []B) ([]C, bool) {
+	if len(list1) != len(list2) {
+		return nil, false
+	}
+	return ZipWithShortest(fun, list1, list2), true
+}
+
+// ZipWith3 combines the elements of three lists of equal length into one list, as in ZipWith. Returns false if the lists have different lengths.
+func ZipWith3[A any, B any, C any, D any](fun func(A, B, C) D, list1 []A, list2 []B, list3 []C) ([]D, bool) {
+	if len(list1) != len(list2) || len(list1) != len(list3) {
+		return nil, false
+	}
+	return ZipWith3Shortest(fun, list1, list2, list3), true
+}
+
+// ZipWith3Longest is like ZipWith3, but the shorter lists are padded with fill1, fill2 and fill3 up to the length of the longest list.
+func ZipWith3Longest[A any, B any, C any, D any](fun func(A, B, C) D, fill1 A, fill2 B, fill3 C, list1 []A, list2 []B, list3 []C) []D {
+	_, n, _ := MinMax([]int{len(list1), len(list2), len(list3)})
+	newList := make([]D, n)
+	for i := range newList {
+		newList[i] = fun(at(list1, i, fill1), at(list2, i, fill2), at(list3, i, fill3))
+	}
+	return newList
+}
+
+// ZipWith3Shortest is like ZipWith3, but the longer lists are trimmed to the length of the shortest list.
+func ZipWith3Shortest[A any, B any, C any, D any](fun func(A, B, C) D, list1 []A, list2 []B, list3 []C) []D {
+	n, _, _ := MinMax([]int{len(list1), len(list2), len(list3)})
+	newList := make([]D, n)
+	for i := range newList {
+		newList[i] = fun(list1[i], list2[i], list3[i])
+	}
+	return newList
+}
+
+// ZipWithLongest is like ZipWith, but the shorter list is padded with fill1 or fill2 up to the length of the longer list.
+func ZipWithLongest[A any, B any, C any](fun func(A, B) C, fill1 A, fill2 B, list1 []A, list2 []B) []C {
+	n := len(list1)
+	if len(list2) > n {
+		n = len(list2)
+	}
+	newList := make([]C, n)
+	for i := range newList {
+		newList[i] = fun(at(list1, i, fill1), at(list2, i, fill2))
+	}
+	return newList
+}
+
+// ZipWithShortest is like ZipWith, but the longer list is trimmed to the length of the shorter list.
+func ZipWithShortest[A any, B any, C any](fun func(A, B) C, list1 []A, list2 []B) []C {
+	n := len(list1)
+	if len(list2) < n {
+		n = len(list2)
+	}
+	newList := make([]C, n)
+	for i := range newList {
+		newList[i] = fun(list1[i], list2[i])
+	}
+	return newList
+}
+
+// at returns the element at position i of list, or fill if list is too short.
+func at[T any](list []T, i int, fill T) T {
+	if i < len(list) {
+		return list[i]
+	}
+	return fill
+}
+
+// pair is the Pair constructor, for use with the ZipWith functions.
+func pair[A any, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{a, b}
+}
+
+// triple is the Triple constructor, for use with the ZipWith3 functions.
+func triple[A any, B any, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{a, b, c}
+}
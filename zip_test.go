@@ -0,0 +1,127 @@
+package lists
+
+import (
+	"testing"
+)
+
+func TestInterleave(t *testing.T) {
+	if !equal(Interleave([]int{1, 2, 3}, []int{10, 20}, []int{100, 200, 300}), []int{1, 10, 100, 2, 20, 200}) {
+		t.Error("Interleave([]int{1, 2, 3}, []int{10, 20}, []int{100, 200, 300}) != []int{1, 10, 100, 2, 20, 200}")
+	}
+	if len(Interleave[int]()) != 0 {
+		t.Error("Interleave() != []int{}")
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	if !equal(RoundRobin([]int{1, 2, 3}, []int{}, []int{10}, []int{100, 200}), []int{1, 10, 100, 2, 200, 3}) {
+		t.Error("RoundRobin([]int{1, 2, 3}, []int{}, []int{10}, []int{100, 200}) != []int{1, 10, 100, 2, 200, 3}")
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+	if !equal(a, []int{1, 2}) || !equal(b, []string{"a", "b"}) {
+		t.Error(`Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}}) != []int{1, 2}, []string{"a", "b"}`)
+	}
+}
+
+func TestUnzip3(t *testing.T) {
+	a, b, c := Unzip3([]Triple[int, string, bool]{{1, "a", true}, {2, "b", false}})
+	if !equal(a, []int{1, 2}) || !equal(b, []string{"a", "b"}) || !equal(c, []bool{true, false}) {
+		t.Error(`Unzip3([]Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}) != []int{1, 2}, []string{"a", "b"}, []bool{true, false}`)
+	}
+}
+
+func TestZip(t *testing.T) {
+	z, ok := Zip([]int{1, 2}, []string{"a", "b"})
+	if !ok || !equal(z, []Pair[int, string]{{1, "a"}, {2, "b"}}) {
+		t.Error(`Zip([]int{1, 2}, []string{"a", "b"}) != []Pair[int, string]{{1, "a"}, {2, "b"}}`)
+	}
+	if _, ok = Zip([]int{1, 2}, []string{"a"}); ok {
+		t.Error(`Zip([]int{1, 2}, []string{"a"}) != false`)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	z, ok := Zip3([]int{1}, []string{"a"}, []bool{true})
+	if !ok || !equal(z, []Triple[int, string, bool]{{1, "a", true}}) {
+		t.Error(`Zip3([]int{1}, []string{"a"}, []bool{true}) != []Triple[int, string, bool]{{1, "a", true}}`)
+	}
+	if _, ok = Zip3([]int{1}, []string{"a"}, []bool{}); ok {
+		t.Error(`Zip3([]int{1}, []string{"a"}, []bool{}) != false`)
+	}
+}
+
+func TestZip3Longest(t *testing.T) {
+	z := Zip3Longest(0, "-", false, []int{1}, []string{"a", "b"}, []bool{})
+	if !equal(z, []Triple[int, string, bool]{{1, "a", false}, {0, "b", false}}) {
+		t.Error(`Zip3Longest(0, "-", false, []int{1}, []string{"a", "b"}, []bool{}) != []Triple[int, string, bool]{{1, "a", false}, {0, "b", false}}`)
+	}
+}
+
+func TestZip3Shortest(t *testing.T) {
+	z := Zip3Shortest([]int{1, 2}, []string{"a", "b", "c"}, []bool{true})
+	if !equal(z, []Triple[int, string, bool]{{1, "a", true}}) {
+		t.Error(`Zip3Shortest([]int{1, 2}, []string{"a", "b", "c"}, []bool{true}) != []Triple[int, string, bool]{{1, "a", true}}`)
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	z := ZipLongest(0, "-", []int{1, 2, 3}, []string{"a"})
+	if !equal(z, []Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}) {
+		t.Error(`ZipLongest(0, "-", []int{1, 2, 3}, []string{"a"}) != []Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}`)
+	}
+}
+
+func TestZipShortest(t *testing.T) {
+	z := ZipShortest([]int{1, 2, 3}, []string{"a"})
+	if !equal(z, []Pair[int, string]{{1, "a"}}) {
+		t.Error(`ZipShortest([]int{1, 2, 3}, []string{"a"}) != []Pair[int, string]{{1, "a"}}`)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	z, ok := ZipWith(func(x, y int) int { return x + y }, []int{1, 2}, []int{10, 20})
+	if !ok || !equal(z, []int{11, 22}) {
+		t.Error("ZipWith(add, []int{1, 2}, []int{10, 20}) != []int{11, 22}")
+	}
+	if _, ok = ZipWith(func(x, y int) int { return x + y }, []int{1}, []int{}); ok {
+		t.Error("ZipWith(add, []int{1}, []int{}) != false")
+	}
+}
+
+func TestZipWith3(t *testing.T) {
+	z, ok := ZipWith3(func(x, y, z int) int { return x + y + z }, []int{1, 2}, []int{10, 20}, []int{100, 200})
+	if !ok || !equal(z, []int{111, 222}) {
+		t.Error("ZipWith3(add, []int{1, 2}, []int{10, 20}, []int{100, 200}) != []int{111, 222}")
+	}
+}
+
+func TestZipWith3Longest(t *testing.T) {
+	z := ZipWith3Longest(func(x, y, z int) int { return x + y + z }, 0, 0, 0, []int{1, 2}, []int{10}, []int{100, 200, 300})
+	if !equal(z, []int{111, 202, 300}) {
+		t.Error("ZipWith3Longest(add, 0, 0, 0, []int{1, 2}, []int{10}, []int{100, 200, 300}) != []int{111, 202, 300}")
+	}
+}
+
+func TestZipWith3Shortest(t *testing.T) {
+	z := ZipWith3Shortest(func(x, y, z int) int { return x + y + z }, []int{1, 2}, []int{10}, []int{100, 200, 300})
+	if !equal(z, []int{111}) {
+		t.Error("ZipWith3Shortest(add, []int{1, 2}, []int{10}, []int{100, 200, 300}) != []int{111}")
+	}
+}
+
+func TestZipWithLongest(t *testing.T) {
+	z := ZipWithLongest(func(x, y int) int { return x * y }, 1, 1, []int{2, 3}, []int{5})
+	if !equal(z, []int{10, 3}) {
+		t.Error("ZipWithLongest(mul, 1, 1, []int{2, 3}, []int{5}) != []int{10, 3}")
+	}
+}
+
+func TestZipWithShortest(t *testing.T) {
+	z := ZipWithShortest(func(x, y int) int { return x * y }, []int{2, 3}, []int{5})
+	if !equal(z, []int{10}) {
+		t.Error("ZipWithShortest(mul, []int{2, 3}, []int{5}) != []int{10}")
+	}
+}